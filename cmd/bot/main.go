@@ -1,6 +1,9 @@
 package main
 
 import (
+	// Embeds the timezone database, the images have none.
+	_ "time/tzdata"
+
 	"context"
 	"os/signal"
 	"syscall"
@@ -8,10 +11,14 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/AFK068/bot/internal/application/bot"
+	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/infrastructure/clients/scrapper"
+	"github.com/AFK068/bot/internal/infrastructure/kafka"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 	"github.com/AFK068/bot/internal/infrastructure/server"
 	"github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
+
+	grpcbotapi "github.com/AFK068/bot/internal/infrastructure/grpcapi/botapi"
 )
 
 const (
@@ -34,9 +41,20 @@ func main() {
 				return botCfg, nil
 			},
 
-			// Provide scrapper client.
-			func(cfg *bot.Config, log *logger.Logger) *scrapper.Client {
-				return scrapper.NewClient(cfg.ScrapperURL, log)
+			// Provide scrapper client for the configured transport.
+			func(cfg *bot.Config, lc fx.Lifecycle, log *logger.Logger) (scrapper.Service, error) {
+				if cfg.ScrapperTransport != domain.GRPCTransport {
+					return scrapper.NewClient(cfg.ScrapperURL, log), nil
+				}
+
+				client, err := scrapper.NewGRPCClient(cfg.ScrapperGRPCAddr, log)
+				if err != nil {
+					return nil, err
+				}
+
+				lc.Append(fx.StopHook(client.Close))
+
+				return client, nil
 			},
 
 			// Provide bot.
@@ -48,10 +66,37 @@ func main() {
 			// Provide bot handler.
 			botapi.NewBotHandler,
 
+			// Provide bot grpc server.
+			func(hd *botapi.BotHandler, log *logger.Logger) *grpcbotapi.BotServer {
+				return grpcbotapi.NewBotServer(hd.HandleLinkUpdate, log)
+			},
+
 			// Provide bot server.
 			server.NewBotServer,
 		),
 		fx.Invoke(
+			// Consume link updates when they are delivered through Kafka.
+			func(cfg *bot.Config, hd *botapi.BotHandler, lc fx.Lifecycle, log *logger.Logger) {
+				if cfg.Transport != domain.KafkaTransport {
+					return
+				}
+
+				ctx, cancel := context.WithCancel(context.Background())
+				consumer := kafka.NewConsumer(cfg.Kafka, hd.HandleLinkUpdate, log)
+
+				go func() {
+					if err := consumer.Run(ctx); err != nil {
+						log.Error("Link updates consumer stopped", "error", err)
+					}
+				}()
+
+				lc.Append(fx.StopHook(func() error {
+					cancel()
+
+					return consumer.Close()
+				}))
+			},
+
 			// Run bot.
 			func(b bot.Service, log *logger.Logger) error {
 				ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
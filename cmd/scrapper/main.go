@@ -1,18 +1,31 @@
 package main
 
 import (
+	// Embeds the timezone database, the images have none.
+	_ "time/tzdata"
+
 	"go.uber.org/fx"
 
+	"github.com/AFK068/bot/internal/application/digest"
+	"github.com/AFK068/bot/internal/application/dispatcher"
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/application/scrapper"
 	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/infrastructure/clients/bot"
 	"github.com/AFK068/bot/internal/infrastructure/httpapi/scrapperapi"
+	"github.com/AFK068/bot/internal/infrastructure/kafka"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 	"github.com/AFK068/bot/internal/infrastructure/repository"
+	"github.com/AFK068/bot/internal/infrastructure/repository/activityrepo"
+	"github.com/AFK068/bot/internal/infrastructure/repository/digestrepo"
+	"github.com/AFK068/bot/internal/infrastructure/repository/outboxrepo"
 	"github.com/AFK068/bot/internal/infrastructure/server"
 	"github.com/AFK068/bot/pkg/client/github"
 	"github.com/AFK068/bot/pkg/client/stackoverflow"
 	"github.com/AFK068/bot/pkg/txs"
+
+	grpcscrapperapi "github.com/AFK068/bot/internal/infrastructure/grpcapi/scrapperapi"
 )
 
 const (
@@ -44,35 +57,105 @@ func main() {
 			// Provide postgres repository.
 			repository.NewPostgresRepo,
 
+			// Provide seen activity repository.
+			fx.Annotate(
+				activityrepo.NewRepository,
+				fx.As(new(domain.ActivityRepository)),
+			),
+
+			// Provide digest repository.
+			fx.Annotate(
+				digestrepo.NewRepository,
+				fx.As(new(domain.DigestRepository)),
+			),
+
+			// Provide outbox repository.
+			fx.Annotate(
+				outboxrepo.NewRepository,
+				fx.As(new(domain.OutboxRepository)),
+			),
+
 			// Provide transactor.
 			fx.Annotate(
 				txs.NewTxBeginner,
 				fx.As(new(scrapperapi.Transactor)),
+				fx.As(new(scrapper.Transactor)),
+				fx.As(new(grpcscrapperapi.Transactor)),
+				fx.As(new(digest.Transactor)),
 			),
 
 			// Provide scrapper handler.
 			scrapperapi.NewScrapperHandler,
 
+			// Provide scrapper grpc server.
+			grpcscrapperapi.NewScrapperServer,
+
 			// Provide stackoverflow client.
 			fx.Annotate(
-				stackoverflow.NewClient,
-				fx.As(new(scrapper.StackOverlowQuestionFetcher)),
+				func(cfg *scrapper.Config) *stackoverflow.Client {
+					return stackoverflow.NewClient(cfg.StackExchangeKey)
+				},
+				fx.As(new(provider.StackOverlowQuestionFetcher)),
 			),
 
 			// Provide github client.
 			fx.Annotate(
-				github.NewClient,
-				fx.As(new(scrapper.GitHubRepoFetcher)),
+				func(cfg *scrapper.Config) *github.Client {
+					return github.NewClient(cfg.GitHubToken)
+				},
+				fx.As(new(provider.GitHubRepoFetcher)),
 			),
 
-			// Provide bot client.
-			func(cfg *scrapper.Config, log *logger.Logger) bot.Service {
-				return bot.NewClient(cfg.BotURL, log)
+			// Provide link providers, every provider of the group is registered.
+			fx.Annotate(
+				provider.NewGitHubProvider,
+				fx.As(new(provider.Provider)),
+				fx.ResultTags(`group:"providers"`),
+			),
+			fx.Annotate(
+				provider.NewStackOverflowProvider,
+				fx.As(new(provider.Provider)),
+				fx.ResultTags(`group:"providers"`),
+			),
+
+			// Provide provider registry.
+			fx.Annotate(
+				provider.NewRegistry,
+				fx.ParamTags(`group:"providers"`),
+			),
+
+			// Provide bot client for the configured transport.
+			func(cfg *scrapper.Config, lc fx.Lifecycle, log *logger.Logger) (bot.Service, error) {
+				switch cfg.Transport {
+				case domain.KafkaTransport:
+					producer := kafka.NewProducer(cfg.Kafka, log)
+
+					lc.Append(fx.StopHook(producer.Close))
+
+					return producer, nil
+				case domain.GRPCTransport:
+					client, err := bot.NewGRPCClient(cfg.BotGRPCAddr, log)
+					if err != nil {
+						return nil, err
+					}
+
+					lc.Append(fx.StopHook(client.Close))
+
+					return client, nil
+				default:
+					return bot.NewClient(cfg.BotURL, log), nil
+				}
 			},
 
 			// Provide scrapper scheduler.
 			scrapper.NewScrapperScheduler,
 
+			// Provide outbox dispatcher.
+			dispatcher.NewDispatcher,
+
+			// Provide digest sender.
+			digest.NewSender,
+
 			// Provide scrapper server.
 			server.NewScrapperServer,
 		),
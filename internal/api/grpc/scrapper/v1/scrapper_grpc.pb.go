@@ -0,0 +1,487 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/grpc/scrapper/v1/scrapper.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ScrapperService_RegisterChat_FullMethodName       = "/scrapper.v1.ScrapperService/RegisterChat"
+	ScrapperService_DeleteChat_FullMethodName         = "/scrapper.v1.ScrapperService/DeleteChat"
+	ScrapperService_ListLinks_FullMethodName          = "/scrapper.v1.ScrapperService/ListLinks"
+	ScrapperService_AddLink_FullMethodName            = "/scrapper.v1.ScrapperService/AddLink"
+	ScrapperService_RemoveLink_FullMethodName         = "/scrapper.v1.ScrapperService/RemoveLink"
+	ScrapperService_MuteLink_FullMethodName           = "/scrapper.v1.ScrapperService/MuteLink"
+	ScrapperService_UrgentLink_FullMethodName         = "/scrapper.v1.ScrapperService/UrgentLink"
+	ScrapperService_ListLinkActivities_FullMethodName = "/scrapper.v1.ScrapperService/ListLinkActivities"
+	ScrapperService_GetChatSettings_FullMethodName    = "/scrapper.v1.ScrapperService/GetChatSettings"
+	ScrapperService_UpdateChatSettings_FullMethodName = "/scrapper.v1.ScrapperService/UpdateChatSettings"
+)
+
+// ScrapperServiceClient is the client API for ScrapperService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ScrapperService mirrors the chat and link endpoints of scrapper-api.yaml.
+// Link methods require the chat to be registered and fail with UNAUTHENTICATED otherwise.
+type ScrapperServiceClient interface {
+	// Register chat.
+	RegisterChat(ctx context.Context, in *RegisterChatRequest, opts ...grpc.CallOption) (*RegisterChatResponse, error)
+	// Remove chat.
+	DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*DeleteChatResponse, error)
+	// Get all tracked links.
+	ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error)
+	// Add link tracking.
+	AddLink(ctx context.Context, in *AddLinkRequest, opts ...grpc.CallOption) (*AddLinkResponse, error)
+	// Remove link tracking.
+	RemoveLink(ctx context.Context, in *RemoveLinkRequest, opts ...grpc.CallOption) (*RemoveLinkResponse, error)
+	// Mute link updates.
+	MuteLink(ctx context.Context, in *MuteLinkRequest, opts ...grpc.CallOption) (*MuteLinkResponse, error)
+	// Change link urgency.
+	UrgentLink(ctx context.Context, in *UrgentLinkRequest, opts ...grpc.CallOption) (*UrgentLinkResponse, error)
+	// Get link activity history.
+	ListLinkActivities(ctx context.Context, in *ListLinkActivitiesRequest, opts ...grpc.CallOption) (*ListLinkActivitiesResponse, error)
+	// Get chat delivery settings.
+	GetChatSettings(ctx context.Context, in *GetChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error)
+	// Update chat delivery settings.
+	UpdateChatSettings(ctx context.Context, in *UpdateChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error)
+}
+
+type scrapperServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScrapperServiceClient(cc grpc.ClientConnInterface) ScrapperServiceClient {
+	return &scrapperServiceClient{cc}
+}
+
+func (c *scrapperServiceClient) RegisterChat(ctx context.Context, in *RegisterChatRequest, opts ...grpc.CallOption) (*RegisterChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterChatResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_RegisterChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) DeleteChat(ctx context.Context, in *DeleteChatRequest, opts ...grpc.CallOption) (*DeleteChatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChatResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_DeleteChat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) ListLinks(ctx context.Context, in *ListLinksRequest, opts ...grpc.CallOption) (*ListLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinksResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_ListLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) AddLink(ctx context.Context, in *AddLinkRequest, opts ...grpc.CallOption) (*AddLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddLinkResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_AddLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) RemoveLink(ctx context.Context, in *RemoveLinkRequest, opts ...grpc.CallOption) (*RemoveLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveLinkResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_RemoveLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) MuteLink(ctx context.Context, in *MuteLinkRequest, opts ...grpc.CallOption) (*MuteLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteLinkResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_MuteLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) UrgentLink(ctx context.Context, in *UrgentLinkRequest, opts ...grpc.CallOption) (*UrgentLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UrgentLinkResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_UrgentLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) ListLinkActivities(ctx context.Context, in *ListLinkActivitiesRequest, opts ...grpc.CallOption) (*ListLinkActivitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLinkActivitiesResponse)
+	err := c.cc.Invoke(ctx, ScrapperService_ListLinkActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) GetChatSettings(ctx context.Context, in *GetChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSettings)
+	err := c.cc.Invoke(ctx, ScrapperService_GetChatSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scrapperServiceClient) UpdateChatSettings(ctx context.Context, in *UpdateChatSettingsRequest, opts ...grpc.CallOption) (*ChatSettings, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatSettings)
+	err := c.cc.Invoke(ctx, ScrapperService_UpdateChatSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScrapperServiceServer is the server API for ScrapperService service.
+// All implementations should embed UnimplementedScrapperServiceServer
+// for forward compatibility.
+//
+// ScrapperService mirrors the chat and link endpoints of scrapper-api.yaml.
+// Link methods require the chat to be registered and fail with UNAUTHENTICATED otherwise.
+type ScrapperServiceServer interface {
+	// Register chat.
+	RegisterChat(context.Context, *RegisterChatRequest) (*RegisterChatResponse, error)
+	// Remove chat.
+	DeleteChat(context.Context, *DeleteChatRequest) (*DeleteChatResponse, error)
+	// Get all tracked links.
+	ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error)
+	// Add link tracking.
+	AddLink(context.Context, *AddLinkRequest) (*AddLinkResponse, error)
+	// Remove link tracking.
+	RemoveLink(context.Context, *RemoveLinkRequest) (*RemoveLinkResponse, error)
+	// Mute link updates.
+	MuteLink(context.Context, *MuteLinkRequest) (*MuteLinkResponse, error)
+	// Change link urgency.
+	UrgentLink(context.Context, *UrgentLinkRequest) (*UrgentLinkResponse, error)
+	// Get link activity history.
+	ListLinkActivities(context.Context, *ListLinkActivitiesRequest) (*ListLinkActivitiesResponse, error)
+	// Get chat delivery settings.
+	GetChatSettings(context.Context, *GetChatSettingsRequest) (*ChatSettings, error)
+	// Update chat delivery settings.
+	UpdateChatSettings(context.Context, *UpdateChatSettingsRequest) (*ChatSettings, error)
+}
+
+// UnimplementedScrapperServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScrapperServiceServer struct{}
+
+func (UnimplementedScrapperServiceServer) RegisterChat(context.Context, *RegisterChatRequest) (*RegisterChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterChat not implemented")
+}
+func (UnimplementedScrapperServiceServer) DeleteChat(context.Context, *DeleteChatRequest) (*DeleteChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteChat not implemented")
+}
+func (UnimplementedScrapperServiceServer) ListLinks(context.Context, *ListLinksRequest) (*ListLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinks not implemented")
+}
+func (UnimplementedScrapperServiceServer) AddLink(context.Context, *AddLinkRequest) (*AddLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddLink not implemented")
+}
+func (UnimplementedScrapperServiceServer) RemoveLink(context.Context, *RemoveLinkRequest) (*RemoveLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLink not implemented")
+}
+func (UnimplementedScrapperServiceServer) MuteLink(context.Context, *MuteLinkRequest) (*MuteLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteLink not implemented")
+}
+func (UnimplementedScrapperServiceServer) UrgentLink(context.Context, *UrgentLinkRequest) (*UrgentLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UrgentLink not implemented")
+}
+func (UnimplementedScrapperServiceServer) ListLinkActivities(context.Context, *ListLinkActivitiesRequest) (*ListLinkActivitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLinkActivities not implemented")
+}
+func (UnimplementedScrapperServiceServer) GetChatSettings(context.Context, *GetChatSettingsRequest) (*ChatSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatSettings not implemented")
+}
+func (UnimplementedScrapperServiceServer) UpdateChatSettings(context.Context, *UpdateChatSettingsRequest) (*ChatSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChatSettings not implemented")
+}
+func (UnimplementedScrapperServiceServer) testEmbeddedByValue() {}
+
+// UnsafeScrapperServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScrapperServiceServer will
+// result in compilation errors.
+type UnsafeScrapperServiceServer interface {
+	mustEmbedUnimplementedScrapperServiceServer()
+}
+
+func RegisterScrapperServiceServer(s grpc.ServiceRegistrar, srv ScrapperServiceServer) {
+	// If the following call pancis, it indicates UnimplementedScrapperServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScrapperService_ServiceDesc, srv)
+}
+
+func _ScrapperService_RegisterChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).RegisterChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_RegisterChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).RegisterChat(ctx, req.(*RegisterChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_DeleteChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).DeleteChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_DeleteChat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).DeleteChat(ctx, req.(*DeleteChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_ListLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).ListLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_ListLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).ListLinks(ctx, req.(*ListLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_AddLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).AddLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_AddLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).AddLink(ctx, req.(*AddLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_RemoveLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).RemoveLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_RemoveLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).RemoveLink(ctx, req.(*RemoveLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_MuteLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).MuteLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_MuteLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).MuteLink(ctx, req.(*MuteLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_UrgentLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UrgentLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).UrgentLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_UrgentLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).UrgentLink(ctx, req.(*UrgentLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_ListLinkActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLinkActivitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).ListLinkActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_ListLinkActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).ListLinkActivities(ctx, req.(*ListLinkActivitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_GetChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).GetChatSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_GetChatSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).GetChatSettings(ctx, req.(*GetChatSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScrapperService_UpdateChatSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChatSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScrapperServiceServer).UpdateChatSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScrapperService_UpdateChatSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScrapperServiceServer).UpdateChatSettings(ctx, req.(*UpdateChatSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScrapperService_ServiceDesc is the grpc.ServiceDesc for ScrapperService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScrapperService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scrapper.v1.ScrapperService",
+	HandlerType: (*ScrapperServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterChat",
+			Handler:    _ScrapperService_RegisterChat_Handler,
+		},
+		{
+			MethodName: "DeleteChat",
+			Handler:    _ScrapperService_DeleteChat_Handler,
+		},
+		{
+			MethodName: "ListLinks",
+			Handler:    _ScrapperService_ListLinks_Handler,
+		},
+		{
+			MethodName: "AddLink",
+			Handler:    _ScrapperService_AddLink_Handler,
+		},
+		{
+			MethodName: "RemoveLink",
+			Handler:    _ScrapperService_RemoveLink_Handler,
+		},
+		{
+			MethodName: "MuteLink",
+			Handler:    _ScrapperService_MuteLink_Handler,
+		},
+		{
+			MethodName: "UrgentLink",
+			Handler:    _ScrapperService_UrgentLink_Handler,
+		},
+		{
+			MethodName: "ListLinkActivities",
+			Handler:    _ScrapperService_ListLinkActivities_Handler,
+		},
+		{
+			MethodName: "GetChatSettings",
+			Handler:    _ScrapperService_GetChatSettings_Handler,
+		},
+		{
+			MethodName: "UpdateChatSettings",
+			Handler:    _ScrapperService_UpdateChatSettings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/grpc/scrapper/v1/scrapper.proto",
+}
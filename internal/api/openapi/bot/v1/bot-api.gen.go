@@ -9,16 +9,6 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-// Defines values for LinkUpdateType.
-const (
-	GithubIssue           LinkUpdateType = "github_issue"
-	GithubPullRequest     LinkUpdateType = "github_pull_request"
-	GithubRepository      LinkUpdateType = "github_repository"
-	StackoverflowAnswer   LinkUpdateType = "stackoverflow_answer"
-	StackoverflowComment  LinkUpdateType = "stackoverflow_comment"
-	StackoverflowQuestion LinkUpdateType = "stackoverflow_question"
-)
-
 // ApiErrorResponse defines model for ApiErrorResponse.
 type ApiErrorResponse struct {
 	Code             *string   `json:"code,omitempty"`
@@ -28,19 +18,58 @@ type ApiErrorResponse struct {
 	Stacktrace       *[]string `json:"stacktrace,omitempty"`
 }
 
+// DigestEntry defines model for DigestEntry.
+type DigestEntry struct {
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Description *string    `json:"description,omitempty"`
+
+	// Noun Name of the activity type given by its provider, such as "issue", "update" when missing.
+	Noun *string   `json:"noun,omitempty"`
+	Tags *[]string `json:"tags,omitempty"`
+
+	// Type Type of the activity reported by the provider of the link, e.g. github_issue or stackoverflow_answer, link_broken when the link can no longer be checked, digest for the buffered updates of a chat, or batch for a burst of activity of the link collapsed into one summary.
+	Type     *LinkUpdateType `json:"type,omitempty"`
+	Url      *string         `json:"url,omitempty"`
+	UserName *string         `json:"userName,omitempty"`
+}
+
 // LinkUpdate defines model for LinkUpdate.
 type LinkUpdate struct {
-	Type        *LinkUpdateType `json:"Type,omitempty"`
-	UserName    *string         `json:"UserName,omitempty"`
-	Description *string         `json:"description,omitempty"`
-	Id          *int64          `json:"id,omitempty"`
-	TgChatIds   *[]int64        `json:"tgChatIds,omitempty"`
-	Url         *string         `json:"url,omitempty"`
-	СreatedAt   *time.Time      `json:"сreatedAt,omitempty"`
+	// Type Type of the activity reported by the provider of the link, e.g. github_issue or stackoverflow_answer, link_broken when the link can no longer be checked, digest for the buffered updates of a chat, or batch for a burst of activity of the link collapsed into one summary.
+	Type     *LinkUpdateType `json:"Type,omitempty"`
+	UserName *string         `json:"UserName,omitempty"`
+
+	// ActivityUrl Web page of the changed object, the link itself when the provider knows no better page.
+	ActivityUrl *string `json:"activityUrl,omitempty"`
+
+	// Batch Updates of a burst of activity of the link, set for the batch type only.
+	Batch       *[]DigestEntry `json:"batch,omitempty"`
+	Description *string        `json:"description,omitempty"`
+
+	// Digest Buffered updates of a chat in digest mode, set for the digest type only.
+	Digest *[]DigestEntry `json:"digest,omitempty"`
+
+	// Emoji Emoji of the activity type given by its provider, a generic one is shown when missing.
+	Emoji *string `json:"emoji,omitempty"`
+
+	// Id ID of the link in the scrapper, the buttons of the update refer to the link by it.
+	Id *int64 `json:"id,omitempty"`
+
+	// Noun Name of the activity type given by its provider, such as "issue", "update" when missing.
+	Noun      *string  `json:"noun,omitempty"`
+	TgChatIds *[]int64 `json:"tgChatIds,omitempty"`
+
+	// Timezone IANA name of the timezone of the chats, times are shown in it. UTC when missing.
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Title Title of the changed issue, pull request or release, empty when it has none.
+	Title     *string    `json:"title,omitempty"`
+	Url       *string    `json:"url,omitempty"`
+	СreatedAt *time.Time `json:"сreatedAt,omitempty"`
 }
 
-// LinkUpdateType defines model for LinkUpdate.Type.
-type LinkUpdateType string
+// LinkUpdateType Type of the activity reported by the provider of the link, e.g. github_issue or stackoverflow_answer, link_broken when the link can no longer be checked, digest for the buffered updates of a chat, or batch for a burst of activity of the link collapsed into one summary.
+type LinkUpdateType = string
 
 // PostUpdatesJSONRequestBody defines body for PostUpdates for application/json ContentType.
 type PostUpdatesJSONRequestBody = LinkUpdate
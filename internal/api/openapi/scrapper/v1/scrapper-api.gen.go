@@ -6,16 +6,32 @@ package v1
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 )
 
+// ActivityResponse defines model for ActivityResponse.
+type ActivityResponse struct {
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Labels      *[]string  `json:"labels,omitempty"`
+	Title       *string    `json:"title,omitempty"`
+	Type        *string    `json:"type,omitempty"`
+	UserName    *string    `json:"userName,omitempty"`
+}
+
 // AddLinkRequest defines model for AddLinkRequest.
 type AddLinkRequest struct {
-	Filters *[]string `json:"filters,omitempty"`
-	Link    *string   `json:"link,omitempty"`
-	Tags    *[]string `json:"tags,omitempty"`
+	// CheckInterval Longest time in seconds between two checks of the link for this chat. The link is checked more often after activity.
+	CheckInterval *int64    `json:"checkInterval,omitempty"`
+	Filters       *[]string `json:"filters,omitempty"`
+	Link          *string   `json:"link,omitempty"`
+	Tags          *[]string `json:"tags,omitempty"`
+
+	// Urgent Updates of the link reach the chat during its quiet hours.
+	Urgent *bool `json:"urgent,omitempty"`
 }
 
 // ApiErrorResponse defines model for ApiErrorResponse.
@@ -27,12 +43,65 @@ type ApiErrorResponse struct {
 	Stacktrace       *[]string `json:"stacktrace,omitempty"`
 }
 
+// ChatSettings defines model for ChatSettings.
+type ChatSettings struct {
+	// DigestMode One of immediate, hourly, daily or weekly. Updates are buffered and sent as one digest per period in all modes but immediate.
+	DigestMode *string `json:"digestMode,omitempty"`
+
+	// DigestTime Time of day of daily and weekly digests in the HH:MM format, in the chat timezone.
+	DigestTime *string `json:"digestTime,omitempty"`
+
+	// DigestWeekday Day of the week of weekly digests, for example monday.
+	DigestWeekday *string `json:"digestWeekday,omitempty"`
+
+	// QuietEnd End of the quiet hours in the HH:MM format, in the chat timezone. Updates of non-urgent links are held until then.
+	QuietEnd *string `json:"quietEnd,omitempty"`
+
+	// QuietStart Start of the quiet hours in the HH:MM format, in the chat timezone. Empty when quiet hours are off.
+	QuietStart *string `json:"quietStart,omitempty"`
+
+	// Timezone IANA timezone of the chat, for example Europe/Berlin. UTC by default.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// DeadLetterResponse defines model for DeadLetterResponse.
+type DeadLetterResponse struct {
+	Attempts    *int32     `json:"attempts,omitempty"`
+	CreatedAt   *time.Time `json:"createdAt,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          *int64     `json:"id,omitempty"`
+	LastError   *string    `json:"lastError,omitempty"`
+	TgChatIds   *[]int64   `json:"tgChatIds,omitempty"`
+	Type        *string    `json:"type,omitempty"`
+	Url         *string    `json:"url,omitempty"`
+}
+
 // LinkResponse defines model for LinkResponse.
 type LinkResponse struct {
-	Filters *[]string `json:"filters,omitempty"`
-	Id      *int64    `json:"id,omitempty"`
-	Tags    *[]string `json:"tags,omitempty"`
-	Url     *string   `json:"url,omitempty"`
+	// Broken The link can no longer be checked and is not tracked until it is added again.
+	Broken *bool `json:"broken,omitempty"`
+
+	// CheckInterval Longest time in seconds between two checks of the link for this chat.
+	CheckInterval *int64    `json:"checkInterval,omitempty"`
+	Filters       *[]string `json:"filters,omitempty"`
+	Id            *int64    `json:"id,omitempty"`
+	Tags          *[]string `json:"tags,omitempty"`
+
+	// Urgent Updates of the link reach the chat during its quiet hours.
+	Urgent *bool   `json:"urgent,omitempty"`
+	Url    *string `json:"url,omitempty"`
+}
+
+// ListActivitiesResponse defines model for ListActivitiesResponse.
+type ListActivitiesResponse struct {
+	Activities *[]ActivityResponse `json:"activities,omitempty"`
+	Size       *int32              `json:"size,omitempty"`
+}
+
+// ListDeadLettersResponse defines model for ListDeadLettersResponse.
+type ListDeadLettersResponse struct {
+	Letters *[]DeadLetterResponse `json:"letters,omitempty"`
+	Size    *int32                `json:"size,omitempty"`
 }
 
 // ListLinksResponse defines model for ListLinksResponse.
@@ -41,11 +110,37 @@ type ListLinksResponse struct {
 	Size  *int32          `json:"size,omitempty"`
 }
 
+// MuteLinkRequest defines model for MuteLinkRequest.
+type MuteLinkRequest struct {
+	// Duration Time in seconds during which the updates of the link are not sent to the chat. Zero unmutes the link.
+	Duration *int64  `json:"duration,omitempty"`
+	Link     *string `json:"link,omitempty"`
+}
+
 // RemoveLinkRequest defines model for RemoveLinkRequest.
 type RemoveLinkRequest struct {
 	Link *string `json:"link,omitempty"`
 }
 
+// UrgentLinkRequest defines model for UrgentLinkRequest.
+type UrgentLinkRequest struct {
+	Link *string `json:"link,omitempty"`
+
+	// Urgent Whether the updates of the link reach the chat during its quiet hours. The link is checked at once.
+	Urgent *bool `json:"urgent,omitempty"`
+}
+
+// GetAdminOutboxDeadParams defines parameters for GetAdminOutboxDead.
+type GetAdminOutboxDeadParams struct {
+	Limit       *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+	XAdminToken string `json:"X-Admin-Token"`
+}
+
+// PostAdminOutboxDeadIdReplayParams defines parameters for PostAdminOutboxDeadIdReplay.
+type PostAdminOutboxDeadIdReplayParams struct {
+	XAdminToken string `json:"X-Admin-Token"`
+}
+
 // DeleteLinksParams defines parameters for DeleteLinks.
 type DeleteLinksParams struct {
 	TgChatId int64 `json:"Tg-Chat-Id"`
@@ -62,14 +157,47 @@ type PostLinksParams struct {
 	TgChatId int64 `json:"Tg-Chat-Id"`
 }
 
+// PostLinksMuteParams defines parameters for PostLinksMute.
+type PostLinksMuteParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// PostLinksUrgentParams defines parameters for PostLinksUrgent.
+type PostLinksUrgentParams struct {
+	TgChatId int64 `json:"Tg-Chat-Id"`
+}
+
+// GetLinksIdActivitiesParams defines parameters for GetLinksIdActivities.
+type GetLinksIdActivitiesParams struct {
+	Since    *time.Time `form:"since,omitempty" json:"since,omitempty"`
+	Type     *string    `form:"type,omitempty" json:"type,omitempty"`
+	Limit    *int64     `form:"limit,omitempty" json:"limit,omitempty"`
+	TgChatId int64      `json:"Tg-Chat-Id"`
+}
+
 // DeleteLinksJSONRequestBody defines body for DeleteLinks for application/json ContentType.
 type DeleteLinksJSONRequestBody = RemoveLinkRequest
 
 // PostLinksJSONRequestBody defines body for PostLinks for application/json ContentType.
 type PostLinksJSONRequestBody = AddLinkRequest
 
+// PostLinksMuteJSONRequestBody defines body for PostLinksMute for application/json ContentType.
+type PostLinksMuteJSONRequestBody = MuteLinkRequest
+
+// PostLinksUrgentJSONRequestBody defines body for PostLinksUrgent for application/json ContentType.
+type PostLinksUrgentJSONRequestBody = UrgentLinkRequest
+
+// PutTgChatIdSettingsJSONRequestBody defines body for PutTgChatIdSettings for application/json ContentType.
+type PutTgChatIdSettingsJSONRequestBody = ChatSettings
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить недоставленные обновления
+	// (GET /admin/outbox/dead)
+	GetAdminOutboxDead(ctx echo.Context, params GetAdminOutboxDeadParams) error
+	// Повторить доставку обновления
+	// (POST /admin/outbox/dead/{id}/replay)
+	PostAdminOutboxDeadIdReplay(ctx echo.Context, id int64, params PostAdminOutboxDeadIdReplayParams) error
 	// Убрать отслеживание ссылки
 	// (DELETE /links)
 	DeleteLinks(ctx echo.Context, params DeleteLinksParams) error
@@ -79,12 +207,27 @@ type ServerInterface interface {
 	// Добавить отслеживание ссылки
 	// (POST /links)
 	PostLinks(ctx echo.Context, params PostLinksParams) error
+	// Приостановить уведомления по ссылке
+	// (POST /links/mute)
+	PostLinksMute(ctx echo.Context, params PostLinksMuteParams) error
+	// Изменить срочность ссылки
+	// (POST /links/urgent)
+	PostLinksUrgent(ctx echo.Context, params PostLinksUrgentParams) error
+	// Получить историю событий ссылки
+	// (GET /links/{id}/activities)
+	GetLinksIdActivities(ctx echo.Context, id int64, params GetLinksIdActivitiesParams) error
 	// Удалить чат
 	// (DELETE /tg-chat/{id})
 	DeleteTgChatId(ctx echo.Context, id int64) error
 	// Зарегистрировать чат
 	// (POST /tg-chat/{id})
 	PostTgChatId(ctx echo.Context, id int64) error
+	// Получить настройки доставки обновлений чата
+	// (GET /tg-chat/{id}/settings)
+	GetTgChatIdSettings(ctx echo.Context, id int64) error
+	// Изменить настройки доставки обновлений чата
+	// (PUT /tg-chat/{id}/settings)
+	PutTgChatIdSettings(ctx echo.Context, id int64) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -92,6 +235,81 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAdminOutboxDead converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminOutboxDead(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminOutboxDeadParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Admin-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Admin-Token: %s", err))
+		}
+
+		params.XAdminToken = XAdminToken
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Admin-Token is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminOutboxDead(ctx, params)
+	return err
+}
+
+// PostAdminOutboxDeadIdReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminOutboxDeadIdReplay(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminOutboxDeadIdReplayParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Admin-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Admin-Token")]; found {
+		var XAdminToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Admin-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Admin-Token", valueList[0], &XAdminToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Admin-Token: %s", err))
+		}
+
+		params.XAdminToken = XAdminToken
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Admin-Token is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminOutboxDeadIdReplay(ctx, id, params)
+	return err
+}
+
 // DeleteLinks converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteLinks(ctx echo.Context) error {
 	var err error
@@ -191,6 +409,126 @@ func (w *ServerInterfaceWrapper) PostLinks(ctx echo.Context) error {
 	return err
 }
 
+// PostLinksMute converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLinksMute(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLinksMuteParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Tg-Chat-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tg-Chat-Id")]; found {
+		var TgChatId int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Tg-Chat-Id, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tg-Chat-Id", valueList[0], &TgChatId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Tg-Chat-Id: %s", err))
+		}
+
+		params.TgChatId = TgChatId
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Tg-Chat-Id is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLinksMute(ctx, params)
+	return err
+}
+
+// PostLinksUrgent converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLinksUrgent(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostLinksUrgentParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Tg-Chat-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tg-Chat-Id")]; found {
+		var TgChatId int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Tg-Chat-Id, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tg-Chat-Id", valueList[0], &TgChatId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Tg-Chat-Id: %s", err))
+		}
+
+		params.TgChatId = TgChatId
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Tg-Chat-Id is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLinksUrgent(ctx, params)
+	return err
+}
+
+// GetLinksIdActivities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLinksIdActivities(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLinksIdActivitiesParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Tg-Chat-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Tg-Chat-Id")]; found {
+		var TgChatId int64
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Tg-Chat-Id, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Tg-Chat-Id", valueList[0], &TgChatId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Tg-Chat-Id: %s", err))
+		}
+
+		params.TgChatId = TgChatId
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Tg-Chat-Id is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLinksIdActivities(ctx, id, params)
+	return err
+}
+
 // DeleteTgChatId converts echo context to params.
 func (w *ServerInterfaceWrapper) DeleteTgChatId(ctx echo.Context) error {
 	var err error
@@ -223,6 +561,38 @@ func (w *ServerInterfaceWrapper) PostTgChatId(ctx echo.Context) error {
 	return err
 }
 
+// GetTgChatIdSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTgChatIdSettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTgChatIdSettings(ctx, id)
+	return err
+}
+
+// PutTgChatIdSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTgChatIdSettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTgChatIdSettings(ctx, id)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -246,15 +616,22 @@ func RegisterHandlers(router EchoRouter, si ServerInterface) {
 // Registers handlers, and prepends BaseURL to the paths, so that the paths
 // can be served under a prefix.
 func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
-	
+
 	wrapper := ServerInterfaceWrapper{
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/admin/outbox/dead", wrapper.GetAdminOutboxDead)
+	router.POST(baseURL+"/admin/outbox/dead/:id/replay", wrapper.PostAdminOutboxDeadIdReplay)
 	router.DELETE(baseURL+"/links", wrapper.DeleteLinks)
 	router.GET(baseURL+"/links", wrapper.GetLinks)
 	router.POST(baseURL+"/links", wrapper.PostLinks)
+	router.POST(baseURL+"/links/mute", wrapper.PostLinksMute)
+	router.POST(baseURL+"/links/urgent", wrapper.PostLinksUrgent)
+	router.GET(baseURL+"/links/:id/activities", wrapper.GetLinksIdActivities)
 	router.DELETE(baseURL+"/tg-chat/:id", wrapper.DeleteTgChatId)
 	router.POST(baseURL+"/tg-chat/:id", wrapper.PostTgChatId)
+	router.GET(baseURL+"/tg-chat/:id/settings", wrapper.GetTgChatIdSettings)
+	router.PUT(baseURL+"/tg-chat/:id/settings", wrapper.PutTgChatIdSettings)
 
 }
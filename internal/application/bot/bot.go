@@ -3,32 +3,51 @@ package bot
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
 	"github.com/AFK068/bot/internal/infrastructure/clients/scrapper"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/pkg/tghtml"
 )
 
 type Service interface {
 	Run(ctx context.Context) error
 	SendMessage(chatID int64, text string, replyMarkup ...interface{})
+	// SendExpandable sends the text with a button showing the details.
+	SendExpandable(chatID int64, text, details string)
+	// SendLinkUpdate sends the HTML text of an update of the link with buttons opening the update,
+	// muting and untracking the link.
+	SendLinkUpdate(chatID int64, text string, actions LinkActions)
+}
+
+// LinkActions are the targets of the buttons of a link update. LinkID is the ID of the link in the scrapper,
+// the update has no mute and untrack buttons when it is zero. OpenURL is the page of the update,
+// the link itself when empty.
+type LinkActions struct {
+	LinkID  int64
+	Link    string
+	OpenURL string
 }
 
 type Bot struct {
 	API            *tgbotapi.BotAPI
 	Config         *Config
-	ScrapperClient *scrapper.Client
+	ScrapperClient scrapper.Service
 	StateManager   *StateManager
+	Callbacks      *CallbackStore
 	Logger         *logger.Logger
 }
 
-func NewBot(log *logger.Logger, cfg *Config, sc *scrapper.Client) *Bot {
+func NewBot(log *logger.Logger, cfg *Config, sc scrapper.Service) *Bot {
 	return &Bot{
 		Logger:         log,
 		Config:         cfg,
 		ScrapperClient: sc,
 		StateManager:   NewStateManager(),
+		Callbacks:      NewCallbackStore(),
 	}
 }
 
@@ -51,32 +70,38 @@ func (b *Bot) Run(ctx context.Context) error {
 	return nil
 }
 
+// SendMessage sends the text, texts over the Telegram limit are sent in parts
+// and the reply markup comes with the last one.
 func (b *Bot) SendMessage(chatID int64, text string, replyMarkup ...interface{}) {
-	msg := tgbotapi.NewMessage(chatID, text)
+	parts := splitMessage(text, MessageLimit)
 
-	if len(replyMarkup) > 0 {
-		if keyboard, ok := replyMarkup[0].(tgbotapi.ReplyKeyboardMarkup); ok {
-			msg.ReplyMarkup = keyboard
-		}
+	for i, part := range parts {
+		msg := tgbotapi.NewMessage(chatID, part)
 
-		if keyboard, ok := replyMarkup[0].(tgbotapi.InlineKeyboardMarkup); ok {
-			msg.ReplyMarkup = keyboard
-		}
+		if len(replyMarkup) > 0 && i == len(parts)-1 {
+			if keyboard, ok := replyMarkup[0].(tgbotapi.ReplyKeyboardMarkup); ok {
+				msg.ReplyMarkup = keyboard
+			}
+
+			if keyboard, ok := replyMarkup[0].(tgbotapi.InlineKeyboardMarkup); ok {
+				msg.ReplyMarkup = keyboard
+			}
 
-		if _, ok := replyMarkup[0].(tgbotapi.ReplyKeyboardRemove); ok {
-			msg.ReplyMarkup = tgbotapi.NewRemoveKeyboard(true)
+			if _, ok := replyMarkup[0].(tgbotapi.ReplyKeyboardRemove); ok {
+				msg.ReplyMarkup = tgbotapi.NewRemoveKeyboard(true)
+			}
 		}
-	}
 
-	if _, err := b.API.Send(msg); err != nil {
-		b.Logger.Error("Sending message",
-			"chatID", chatID,
-			"text", text,
-			"replyMarkup", replyMarkup,
-			"error", err,
-		)
+		if _, err := b.API.Send(msg); err != nil {
+			b.Logger.Error("Sending message",
+				"chatID", chatID,
+				"text", part,
+				"replyMarkup", replyMarkup,
+				"error", err,
+			)
 
-		return
+			return
+		}
 	}
 
 	b.Logger.Info("Message sent",
@@ -86,6 +111,99 @@ func (b *Bot) SendMessage(chatID int64, text string, replyMarkup ...interface{})
 	)
 }
 
+func (b *Bot) SendExpandable(chatID int64, text, details string) {
+	id := b.Callbacks.Add(details)
+
+	keyboard := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(ExpandButton, ExpandCallback+":"+strconv.FormatUint(id, 10)),
+		),
+	)
+
+	b.SendMessage(chatID, text, keyboard)
+}
+
+func (b *Bot) SendLinkUpdate(chatID int64, text string, actions LinkActions) {
+	openURL := actions.OpenURL
+	if openURL == "" {
+		openURL = actions.Link
+	}
+
+	rows := [][]tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonURL(OpenButton, openURL),
+		),
+	}
+
+	// The buttons refer to the link by its ID in the scrapper, which outlives the bot and fits in the callback data.
+	if actions.LinkID != 0 {
+		id := strconv.FormatInt(actions.LinkID, 10)
+
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(MuteButton, MuteCallback+":"+id),
+			tgbotapi.NewInlineKeyboardButtonData(UntrackButton, UntrackCallback+":"+id),
+		))
+	}
+
+	// Updates over the Telegram limit are split without breaking tags, the buttons come with the last part.
+	parts := tghtml.Split(text, MessageLimit)
+
+	for i, part := range parts {
+		msg := tgbotapi.NewMessage(chatID, part)
+		msg.ParseMode = tgbotapi.ModeHTML
+		msg.DisableWebPagePreview = true
+
+		if i == len(parts)-1 {
+			msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(rows...)
+		}
+
+		if _, err := b.API.Send(msg); err != nil {
+			b.Logger.Error("Sending link update", "chatID", chatID, "text", part, "link", actions.Link, "error", err)
+			return
+		}
+	}
+
+	b.Logger.Info("Link update sent", "chatID", chatID, "link", actions.Link)
+}
+
+// splitMessage splits the text into parts of at most limit runes, at line breaks when possible.
+func splitMessage(text string, limit int) []string {
+	var (
+		parts   []string
+		current strings.Builder
+		size    int
+	)
+
+	flush := func() {
+		if size > 0 {
+			parts = append(parts, current.String())
+			current.Reset()
+
+			size = 0
+		}
+	}
+
+	for _, line := range strings.SplitAfter(text, "\n") {
+		runes := []rune(line)
+
+		if size+len(runes) > limit {
+			flush()
+		}
+
+		for len(runes) > limit {
+			parts = append(parts, string(runes[:limit]))
+			runes = runes[limit:]
+		}
+
+		current.WriteString(string(runes))
+		size += len(runes)
+	}
+
+	flush()
+
+	return parts
+}
+
 func (b *Bot) processUpdates(ctx context.Context, updates tgbotapi.UpdatesChannel) {
 	for {
 		select {
@@ -94,6 +212,11 @@ func (b *Bot) processUpdates(ctx context.Context, updates tgbotapi.UpdatesChanne
 				return
 			}
 
+			if update.CallbackQuery != nil {
+				b.handleCallback(update.CallbackQuery)
+				continue
+			}
+
 			if update.Message == nil {
 				continue
 			}
@@ -149,6 +272,26 @@ func (b *Bot) initBotCommands() tgbotapi.SetMyCommandsConfig {
 			Command:     ListCommand,
 			Description: ListCommandDescription,
 		},
+		{
+			Command:     HistoryCommand,
+			Description: HistoryCommandDescription,
+		},
+		{
+			Command:     DigestCommand,
+			Description: DigestCommandDescription,
+		},
+		{
+			Command:     TimezoneCommand,
+			Description: TimezoneCommandDescription,
+		},
+		{
+			Command:     QuietCommand,
+			Description: QuietCommandDescription,
+		},
+		{
+			Command:     UrgentCommand,
+			Description: UrgentCommandDescription,
+		},
 	}
 
 	return tgbotapi.SetMyCommandsConfig{
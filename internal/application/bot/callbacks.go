@@ -0,0 +1,51 @@
+package bot
+
+import (
+	"sync"
+)
+
+// CallbackStoreLimit is the number of values kept for the expand buttons of the sent messages. The values
+// of older messages are dropped, as are all of them when the bot restarts.
+const CallbackStoreLimit = 1000
+
+// CallbackStore keeps the details shown by the expand buttons of the sent messages, as callback data
+// is limited to 64 bytes. The buttons of link updates refer to the link by its ID instead.
+type CallbackStore struct {
+	mu     sync.Mutex
+	next   uint64
+	values map[uint64]string
+	order  []uint64
+}
+
+func NewCallbackStore() *CallbackStore {
+	return &CallbackStore{
+		values: make(map[uint64]string),
+	}
+}
+
+// Add stores the value and returns its ID, dropping the oldest ones above the limit.
+func (s *CallbackStore) Add(value string) uint64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.next++
+	s.values[s.next] = value
+	s.order = append(s.order, s.next)
+
+	if len(s.order) > CallbackStoreLimit {
+		delete(s.values, s.order[0])
+		s.order = s.order[1:]
+	}
+
+	return s.next
+}
+
+// Get returns the value with the ID, if it is still kept.
+func (s *CallbackStore) Get(id uint64) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value, ok := s.values[id]
+
+	return value, ok
+}
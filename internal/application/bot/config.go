@@ -1,20 +1,51 @@
 package bot
 
-import "github.com/ilyakaznacheev/cleanenv"
+import (
+	"fmt"
+
+	"github.com/ilyakaznacheev/cleanenv"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/domain"
+)
 
 type Config struct {
 	Token       string `yaml:"token" env:"BOT_TOKEN" env-required:"true"`
 	Host        string `yaml:"host" env:"BOT_HOST" env-required:"true"`
 	Port        string `yaml:"port" env:"BOT_PORT" env-required:"true"`
 	ScrapperURL string `yaml:"scrapper_url" env:"BOT_SCRAPPER_URL" env-required:"true"`
+
+	GRPCPort         string `yaml:"grpc_port" env:"BOT_GRPC_PORT" env-default:"9080"`
+	ScrapperGRPCAddr string `yaml:"scrapper_grpc_addr" env:"BOT_SCRAPPER_GRPC_ADDR" env-default:"scrapper:9081"`
+
+	// ScrapperTransport selects how the bot calls the scrapper: http or grpc.
+	ScrapperTransport domain.TransportType `yaml:"scrapper_transport" env:"BOT_SCRAPPER_TRANSPORT" env-default:"http"`
+
+	// Transport selects how link updates are received from the scrapper. Updates
+	// sent over HTTP and gRPC are always accepted, kafka starts the topic consumer.
+	Transport domain.TransportType `yaml:"transport" env:"TRANSPORT" env-default:"http"`
+	Kafka     config.Kafka         `yaml:"kafka"`
 }
 
 func NewConfig(file string) (*Config, error) {
-	config := &Config{}
+	cfg := &Config{}
 
-	if err := cleanenv.ReadConfig(file, config); err != nil {
+	if err := cleanenv.ReadConfig(file, cfg); err != nil {
 		return nil, err
 	}
 
-	return config, nil
+	transport, err := domain.ParseTransport(cfg.Transport, domain.HTTPTransport, domain.KafkaTransport, domain.GRPCTransport)
+	if err != nil {
+		return nil, fmt.Errorf("transport: %w", err)
+	}
+
+	scrapperTransport, err := domain.ParseTransport(cfg.ScrapperTransport, domain.HTTPTransport, domain.GRPCTransport)
+	if err != nil {
+		return nil, fmt.Errorf("scrapper_transport: %w", err)
+	}
+
+	cfg.Transport = transport
+	cfg.ScrapperTransport = scrapperTransport
+
+	return cfg, nil
 }
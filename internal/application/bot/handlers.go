@@ -5,18 +5,31 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
 
+	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/pkg/tghtml"
 	"github.com/AFK068/bot/pkg/utils"
 
 	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
 )
 
+// HistoryLimit is the number of the last events shown by /history.
+const HistoryLimit = 10
+
+// MuteDuration is the time the mute button of a link update stops the updates of the link for.
+const MuteDuration = 24 * time.Hour
+
 func (b *Bot) handleCommand(msg *tgbotapi.Message) {
 	chatID := msg.Chat.ID
 	command := msg.Command()
@@ -34,11 +47,123 @@ func (b *Bot) handleCommand(msg *tgbotapi.Message) {
 		b.handleUntrack(chatID, msg.CommandArguments())
 	case ListCommand:
 		b.handleList(chatID, msg.CommandArguments())
+	case HistoryCommand:
+		b.handleHistory(chatID, msg.CommandArguments())
+	case DigestCommand:
+		b.handleDigest(chatID, msg.CommandArguments())
+	case TimezoneCommand:
+		b.handleTimezone(chatID, msg.CommandArguments())
+	case QuietCommand:
+		b.handleQuiet(chatID, msg.CommandArguments())
+	case UrgentCommand:
+		b.handleUrgent(chatID, msg.CommandArguments())
 	default:
 		b.SendMessage(chatID, "Unknown command. Use /help to see the list of available commands.")
 	}
 }
 
+// handleCallback handles the inline buttons of the sent messages. Their data is the action and its argument.
+func (b *Bot) handleCallback(query *tgbotapi.CallbackQuery) {
+	if _, err := b.API.Request(tgbotapi.NewCallback(query.ID, "")); err != nil {
+		b.Logger.Error("Error answering callback query", "error", err)
+	}
+
+	if query.Message == nil {
+		return
+	}
+
+	chatID := query.Message.Chat.ID
+	action, arg, _ := strings.Cut(query.Data, ":")
+
+	b.Logger.Info("Received callback", "chatID", chatID, "action", action)
+
+	switch action {
+	case ExpandCallback:
+		b.handleExpand(chatID, arg)
+	case MuteCallback:
+		if link, ok := b.trackedLink(chatID, arg); ok {
+			b.handleMute(chatID, link)
+		}
+	case UntrackCallback:
+		if link, ok := b.trackedLink(chatID, arg); ok {
+			b.handleUntrack(chatID, link)
+		}
+	default:
+		b.Logger.Warn("Unknown callback", "chatID", chatID, "data", query.Data)
+		b.SendMessage(chatID, "This button is no longer available.")
+	}
+}
+
+// trackedLink returns the tracked link of the chat with the ID of the button, telling the chat when it is no longer tracked.
+func (b *Bot) trackedLink(chatID int64, arg string) (string, bool) {
+	id, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		b.Logger.Warn("Invalid link ID", "chatID", chatID, "ID", arg)
+		return "", false
+	}
+
+	links, err := b.ScrapperClient.GetLinks(context.Background(), chatID)
+	if err != nil {
+		b.Logger.Error("Error getting links", "error", err)
+		b.handleError(chatID, err)
+
+		return "", false
+	}
+
+	if links.Links != nil {
+		for _, link := range *links.Links {
+			if aws.Int64Value(link.Id) == id {
+				return aws.StringValue(link.Url), true
+			}
+		}
+	}
+
+	b.SendMessage(chatID, "This link is no longer tracked. Use /list to see the tracked links.")
+
+	return "", false
+}
+
+// handleExpand sends the details behind the expand button of a message.
+func (b *Bot) handleExpand(chatID int64, arg string) {
+	if details, ok := b.callbackValue(chatID, arg); ok {
+		b.SendMessage(chatID, details)
+	}
+}
+
+// callbackValue returns the value behind the button with the ID, telling the chat when it is no longer kept.
+func (b *Bot) callbackValue(chatID int64, arg string) (string, bool) {
+	id, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		b.Logger.Warn("Invalid callback ID", "chatID", chatID, "ID", arg)
+		return "", false
+	}
+
+	value, ok := b.Callbacks.Get(id)
+	if !ok {
+		b.SendMessage(chatID, "This button is no longer available.")
+		return "", false
+	}
+
+	return value, true
+}
+
+// handleMute stops sending the updates of the link to the chat for MuteDuration.
+func (b *Bot) handleMute(chatID int64, link string) {
+	if err := b.ScrapperClient.MuteLink(context.Background(), chatID, scrappertypes.MuteLinkRequest{
+		Link:     aws.String(link),
+		Duration: aws.Int64(int64(MuteDuration / time.Second)),
+	}); err != nil {
+		b.Logger.Error("Error muting link", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	until := time.Now().Add(MuteDuration).In(b.chatLocation(chatID))
+
+	b.SendMessage(chatID, fmt.Sprintf("Updates of %s are muted until %s.", link, until.Format("2006-01-02 15:04 MST")))
+}
+
 func (b *Bot) handleMessage(msg *tgbotapi.Message) {
 	chatID := msg.Chat.ID
 	text := msg.Text
@@ -53,7 +178,7 @@ func (b *Bot) handleMessage(msg *tgbotapi.Message) {
 
 	switch conv.FSM.Current() {
 	case ConversationStateAwaitingURL:
-		if strings.Contains(text, "github.com") || strings.Contains(text, "stackoverflow.com") {
+		if isLink(text) {
 			conv.URL = text
 
 			if err := conv.FSM.Event(context.Background(), EventSetURL); err != nil {
@@ -80,7 +205,8 @@ func (b *Bot) handleMessage(msg *tgbotapi.Message) {
 			return
 		}
 
-		b.SendMessage(chatID, "Enter filters separated by spaces (optional):", skipKeyboard)
+		b.SendMessage(chatID, "Enter filters separated by spaces (optional).\n"+
+			"Example: user:octocat -user:dependabot type:pull_request label:bug text:~regex", skipKeyboard)
 
 	case ConversationStateAwaitingFilter:
 		if text != "" && text != SkipOption {
@@ -164,12 +290,334 @@ func (b *Bot) handleList(chatID int64, tag ...string) {
 	builder.WriteString("Tracked links:\n")
 
 	for _, link := range *links.Links {
+		if link.Broken != nil && *link.Broken {
+			builder.WriteString(fmt.Sprintf("- %s (broken, use /track to retry)\n", *link.Url))
+			continue
+		}
+
+		if link.Urgent != nil && *link.Urgent {
+			builder.WriteString(fmt.Sprintf("- %s (urgent)\n", *link.Url))
+			continue
+		}
+
 		builder.WriteString(fmt.Sprintf("- %s\n", *link.Url))
 	}
 
 	b.SendMessage(chatID, builder.String())
 }
 
+// handleHistory shows the last events of the tracked link with the given URL,
+// or of all tracked links with the given tag, the newest first.
+func (b *Bot) handleHistory(chatID int64, arg string) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		b.SendMessage(chatID, "Specify the link or tag: /history <link|tag>")
+		return
+	}
+
+	links, err := b.ScrapperClient.GetLinks(context.Background(), chatID)
+	if err != nil {
+		b.Logger.Error("Error getting links", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	events, err := b.historyEvents(chatID, historyLinks(links, arg))
+	if err != nil {
+		b.Logger.Error("Error getting link activities", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	if len(events) == 0 {
+		b.SendMessage(chatID, fmt.Sprintf("No events found for %s.", arg))
+		return
+	}
+
+	location := b.chatLocation(chatID)
+
+	var builder strings.Builder
+
+	builder.WriteString(fmt.Sprintf("Last events of %s:\n", arg))
+
+	for _, event := range events {
+		builder.WriteString(fmt.Sprintf("\n%s %s\n%s\n",
+			aws.TimeValue(event.activity.CreatedAt).In(location).Format("2006-01-02 15:04 MST"),
+			aws.StringValue(event.activity.Type),
+			event.url,
+		))
+
+		if description := aws.StringValue(event.activity.Description); description != "" {
+			builder.WriteString(fmt.Sprintf("Description: %s\n", tghtml.PlainText(description)))
+		}
+
+		if userName := aws.StringValue(event.activity.UserName); userName != "" {
+			builder.WriteString(fmt.Sprintf("Updated by: %s\n", userName))
+		}
+	}
+
+	b.SendMessage(chatID, builder.String())
+}
+
+// historyEvent is an activity of a tracked link shown by /history.
+type historyEvent struct {
+	url      string
+	activity scrappertypes.ActivityResponse
+}
+
+// historyEvents returns the last HistoryLimit events of the links, the newest first.
+func (b *Bot) historyEvents(chatID int64, links []scrappertypes.LinkResponse) ([]historyEvent, error) {
+	var events []historyEvent
+
+	for _, link := range links {
+		activities, err := b.ScrapperClient.GetLinkActivities(
+			context.Background(),
+			aws.Int64Value(link.Id),
+			scrappertypes.GetLinksIdActivitiesParams{TgChatId: chatID, Limit: aws.Int64(HistoryLimit)},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, activity := range *activities.Activities {
+			events = append(events, historyEvent{url: aws.StringValue(link.Url), activity: activity})
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return aws.TimeValue(events[i].activity.CreatedAt).After(aws.TimeValue(events[j].activity.CreatedAt))
+	})
+
+	if len(events) > HistoryLimit {
+		events = events[:HistoryLimit]
+	}
+
+	return events, nil
+}
+
+// historyLinks returns the tracked link with the given URL, or the tracked links with the given tag.
+func historyLinks(links scrappertypes.ListLinksResponse, arg string) []scrappertypes.LinkResponse {
+	if links.Links == nil {
+		return nil
+	}
+
+	for _, link := range *links.Links {
+		if aws.StringValue(link.Url) == arg {
+			return []scrappertypes.LinkResponse{link}
+		}
+	}
+
+	var tagged []scrappertypes.LinkResponse
+
+	for _, link := range *links.Links {
+		if link.Tags != nil && slices.Contains(*link.Tags, arg) {
+			tagged = append(tagged, link)
+		}
+	}
+
+	return tagged
+}
+
+// handleDigest shows the delivery settings of the chat, or changes them when arguments are given:
+// the mode, then the weekday of weekly digests and the time of daily and weekly ones.
+func (b *Bot) handleDigest(chatID int64, args string) {
+	fields := strings.Fields(args)
+
+	switch {
+	case len(fields) == 0:
+		b.showSettings(chatID)
+	case strings.EqualFold(fields[0], string(domain.DigestDaily)) && len(fields) == 2:
+		b.updateSettings(chatID, func(settings *scrappertypes.ChatSettings) {
+			settings.DigestMode = aws.String(fields[0])
+			settings.DigestTime = aws.String(fields[1])
+		})
+	case strings.EqualFold(fields[0], string(domain.DigestWeekly)) && len(fields) == 3:
+		b.updateSettings(chatID, func(settings *scrappertypes.ChatSettings) {
+			settings.DigestMode = aws.String(fields[0])
+			settings.DigestWeekday = aws.String(fields[1])
+			settings.DigestTime = aws.String(fields[2])
+		})
+	case len(fields) == 1:
+		b.updateSettings(chatID, func(settings *scrappertypes.ChatSettings) {
+			settings.DigestMode = aws.String(fields[0])
+		})
+	default:
+		b.SendMessage(chatID, "Usage: /digest immediate|hourly|daily HH:MM|weekly <day> HH:MM")
+	}
+}
+
+// handleTimezone shows the delivery settings of the chat, or changes its timezone to the given IANA name.
+func (b *Bot) handleTimezone(chatID int64, args string) {
+	fields := strings.Fields(args)
+
+	switch len(fields) {
+	case 0:
+		b.showSettings(chatID)
+	case 1:
+		b.updateSettings(chatID, func(settings *scrappertypes.ChatSettings) {
+			settings.Timezone = aws.String(fields[0])
+		})
+	default:
+		b.SendMessage(chatID, "Usage: /timezone <IANA name>, for example /timezone Europe/Berlin")
+	}
+}
+
+// handleQuiet shows the delivery settings of the chat, or changes its quiet hours: their start and end, or off.
+func (b *Bot) handleQuiet(chatID int64, args string) {
+	fields := strings.Fields(args)
+
+	switch {
+	case len(fields) == 0:
+		b.showSettings(chatID)
+	case len(fields) == 1 && strings.EqualFold(fields[0], "off"):
+		b.updateSettings(chatID, func(settings *scrappertypes.ChatSettings) {
+			settings.QuietStart = nil
+			settings.QuietEnd = nil
+		})
+	case len(fields) == 2:
+		b.updateSettings(chatID, func(settings *scrappertypes.ChatSettings) {
+			settings.QuietStart = aws.String(fields[0])
+			settings.QuietEnd = aws.String(fields[1])
+		})
+	default:
+		b.SendMessage(chatID, "Usage: /quiet HH:MM HH:MM|off, for example /quiet 23:00 07:00")
+	}
+}
+
+func (b *Bot) showSettings(chatID int64) {
+	settings, err := b.ScrapperClient.GetChatSettings(context.Background(), chatID)
+	if err != nil {
+		b.Logger.Error("Error getting chat settings", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	b.SendMessage(chatID, describeSettings(settings))
+}
+
+// updateSettings applies the change to the current delivery settings of the chat, so that
+// the commands changing one of them keep the others.
+func (b *Bot) updateSettings(chatID int64, change func(settings *scrappertypes.ChatSettings)) {
+	settings, err := b.ScrapperClient.GetChatSettings(context.Background(), chatID)
+	if err != nil {
+		b.Logger.Error("Error getting chat settings", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	change(&settings)
+
+	settings, err = b.ScrapperClient.PutChatSettings(context.Background(), chatID, settings)
+	if err != nil {
+		b.Logger.Error("Error updating chat settings", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	b.SendMessage(chatID, describeSettings(settings))
+}
+
+// chatLocation returns the timezone of the chat, or UTC when its settings are unavailable.
+func (b *Bot) chatLocation(chatID int64) *time.Location {
+	settings, err := b.ScrapperClient.GetChatSettings(context.Background(), chatID)
+	if err != nil {
+		b.Logger.Warn("Error getting chat settings, using UTC", "error", err)
+		return time.UTC
+	}
+
+	return domain.LoadLocation(aws.StringValue(settings.Timezone))
+}
+
+// describeSettings returns the delivery settings in words.
+func describeSettings(settings scrappertypes.ChatSettings) string {
+	timezone := aws.StringValue(settings.Timezone)
+	if timezone == "" {
+		timezone = domain.DefaultTimezone
+	}
+
+	var description string
+
+	switch domain.DigestMode(aws.StringValue(settings.DigestMode)) {
+	case domain.DigestHourly:
+		description = "Updates are sent as an hourly digest."
+	case domain.DigestDaily:
+		description = fmt.Sprintf("Updates are sent as a daily digest at %s.", aws.StringValue(settings.DigestTime))
+	case domain.DigestWeekly:
+		description = fmt.Sprintf("Updates are sent as a weekly digest on %s at %s.",
+			aws.StringValue(settings.DigestWeekday), aws.StringValue(settings.DigestTime))
+	default:
+		description = "Updates are sent immediately. Use /digest hourly, /digest daily HH:MM or /digest weekly <day> HH:MM to group them."
+	}
+
+	description += fmt.Sprintf("\nTimezone: %s. Use /timezone <IANA name> to change it.", timezone)
+
+	if aws.StringValue(settings.QuietStart) != "" {
+		description += fmt.Sprintf("\nQuiet hours: %s-%s. Updates of links marked with /urgent are still sent immediately.",
+			aws.StringValue(settings.QuietStart), aws.StringValue(settings.QuietEnd))
+	} else {
+		description += "\nQuiet hours are off. Use /quiet HH:MM HH:MM to hold updates at night."
+	}
+
+	return description
+}
+
+// handleUrgent toggles whether the updates of the tracked link reach the chat during its quiet hours.
+func (b *Bot) handleUrgent(chatID int64, link string) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		b.SendMessage(chatID, "Specify the tracked link: /urgent <link>")
+		return
+	}
+
+	links, err := b.ScrapperClient.GetLinks(context.Background(), chatID)
+	if err != nil {
+		b.Logger.Error("Error getting links", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	var tracked *scrappertypes.LinkResponse
+
+	if links.Links != nil {
+		for i := range *links.Links {
+			if aws.StringValue((*links.Links)[i].Url) == link {
+				tracked = &(*links.Links)[i]
+				break
+			}
+		}
+	}
+
+	if tracked == nil {
+		b.SendMessage(chatID, fmt.Sprintf("%s is not tracked. Use /list to see the tracked links.", link))
+		return
+	}
+
+	urgent := !aws.BoolValue(tracked.Urgent)
+
+	// Adding the link again would move the last check of the chat and skip the activity since it.
+	if err := b.ScrapperClient.UrgentLink(context.Background(), chatID, scrappertypes.UrgentLinkRequest{
+		Link:   tracked.Url,
+		Urgent: aws.Bool(urgent),
+	}); err != nil {
+		b.Logger.Error("Error updating link", "error", err)
+		b.handleError(chatID, err)
+
+		return
+	}
+
+	if urgent {
+		b.SendMessage(chatID, fmt.Sprintf("Updates of %s now reach you during quiet hours.", link))
+	} else {
+		b.SendMessage(chatID, fmt.Sprintf("Updates of %s are now held during quiet hours.", link))
+	}
+}
+
 func (b *Bot) handleStart(chatID int64) {
 	if err := b.ScrapperClient.PostTgChatID(context.Background(), chatID); err != nil {
 		b.Logger.Error("Error posting chat ID", "error", err)
@@ -187,12 +635,22 @@ func (b *Bot) handleHelp(chatID int64) {
 /%s - %s
 /%s - %s
 /%s - %s
+/%s - %s
+/%s - %s
+/%s - %s
+/%s - %s
+/%s - %s
 /%s - %s`,
 		StartCommand, StartCommandDescription,
 		HelpCommand, HelpCommandDescription,
 		TrackCommand, TrackCommandDescription,
 		UntrackCommand, UntrackCommandDescription,
 		ListCommand, ListCommandDescription,
+		HistoryCommand, HistoryCommandDescription,
+		DigestCommand, DigestCommandDescription,
+		TimezoneCommand, TimezoneCommandDescription,
+		QuietCommand, QuietCommandDescription,
+		UrgentCommand, UrgentCommandDescription,
 	)
 
 	b.SendMessage(chatID, helpText, mainKeyboard)
@@ -220,3 +678,10 @@ func (b *Bot) handleError(chatID int64, err error) {
 		b.SendMessage(chatID, "⚠️ An internal error occurred")
 	}
 }
+
+// isLink reports whether the text is an HTTP link. Whether its source is supported
+// is decided by the providers of the scrapper when the link is added.
+func isLink(text string) bool {
+	parsed, err := url.ParseRequestURI(text)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
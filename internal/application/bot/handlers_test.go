@@ -0,0 +1,134 @@
+package bot
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api/v5"
+
+	"github.com/AFK068/bot/internal/infrastructure/clients/scrapper/mocks"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+)
+
+// telegramServer fakes the Telegram Bot API and records the sent messages.
+type telegramServer struct {
+	mu       sync.Mutex
+	messages []url.Values
+}
+
+func newTestBot(t *testing.T, sc *mocks.Service) (*Bot, *telegramServer) {
+	telegram := &telegramServer{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+
+		result := `true`
+
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/getMe"):
+			result = `{"id":1,"is_bot":true,"username":"test_bot"}`
+		case strings.HasSuffix(r.URL.Path, "/sendMessage"):
+			telegram.mu.Lock()
+			telegram.messages = append(telegram.messages, r.PostForm)
+			telegram.mu.Unlock()
+
+			result = `{"message_id":1,"chat":{"id":` + r.PostForm.Get("chat_id") + `}}`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, err := w.Write([]byte(`{"ok":true,"result":` + result + `}`))
+		assert.NoError(t, err)
+	}))
+
+	t.Cleanup(server.Close)
+
+	api, err := tgbotapi.NewBotAPIWithAPIEndpoint("token", server.URL+"/bot%s/%s")
+	require.NoError(t, err)
+
+	b := NewBot(logger.NewDiscardLogger(), &Config{}, sc)
+	b.API = api
+
+	return b, telegram
+}
+
+func (s *telegramServer) last() url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.messages[len(s.messages)-1]
+}
+
+// linkButton returns the callback data of the button of the last sent message.
+func (s *telegramServer) linkButton(t *testing.T, row, column int) string {
+	var markup tgbotapi.InlineKeyboardMarkup
+	require.NoError(t, json.Unmarshal([]byte(s.last().Get("reply_markup")), &markup))
+
+	return *markup.InlineKeyboard[row][column].CallbackData
+}
+
+func callbackQuery(chatID int64, data string) *tgbotapi.CallbackQuery {
+	return &tgbotapi.CallbackQuery{
+		ID:      "1",
+		Message: &tgbotapi.Message{Chat: &tgbotapi.Chat{ID: chatID}},
+		Data:    data,
+	}
+}
+
+func Test_HandleCallback_MuteAfterRestart(t *testing.T) {
+	sc := mocks.NewService(t)
+	b, telegram := newTestBot(t, sc)
+
+	link := "https://github.com/owner/repo"
+
+	b.SendLinkUpdate(123, "Update", LinkActions{LinkID: 7, Link: link})
+	data := telegram.linkButton(t, 1, 0)
+
+	// The store is emptied when the bot restarts, the button still refers to the link.
+	b.Callbacks = NewCallbackStore()
+
+	sc.On("GetLinks", mock.Anything, int64(123)).Return(scrappertypes.ListLinksResponse{
+		Links: &[]scrappertypes.LinkResponse{
+			{Id: aws.Int64(3), Url: aws.String("https://github.com/owner/other")},
+			{Id: aws.Int64(7), Url: aws.String(link)},
+		},
+	}, nil).Once()
+
+	sc.On("MuteLink", mock.Anything, int64(123), scrappertypes.MuteLinkRequest{
+		Link:     aws.String(link),
+		Duration: aws.Int64(int64(MuteDuration.Seconds())),
+	}).Return(nil).Once()
+
+	sc.On("GetChatSettings", mock.Anything, int64(123)).Return(scrappertypes.ChatSettings{}, nil).Once()
+
+	b.handleCallback(callbackQuery(123, data))
+
+	assert.Contains(t, telegram.last().Get("text"), "Updates of "+link+" are muted until")
+}
+
+func Test_HandleCallback_UntrackedLink(t *testing.T) {
+	sc := mocks.NewService(t)
+	b, telegram := newTestBot(t, sc)
+
+	b.SendLinkUpdate(123, "Update", LinkActions{LinkID: 7, Link: "https://github.com/owner/repo"})
+	data := telegram.linkButton(t, 1, 1)
+
+	sc.On("GetLinks", mock.Anything, int64(123)).Return(scrappertypes.ListLinksResponse{
+		Links: &[]scrappertypes.LinkResponse{},
+	}, nil).Once()
+
+	b.handleCallback(callbackQuery(123, data))
+
+	assert.Equal(t, "This link is no longer tracked. Use /list to see the tracked links.", telegram.last().Get("text"))
+}
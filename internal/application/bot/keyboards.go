@@ -20,7 +20,36 @@ const (
 	ListCommand            = "list"
 	ListCommandDescription = "Show list of tracked links.\nYou can also use /list <tag> to filter by tag"
 
+	HistoryCommand            = "history"
+	HistoryCommandDescription = "Show the last events of a link or of the links with a tag: /history <link|tag>"
+
+	DigestCommand            = "digest"
+	DigestCommandDescription = "Show or change how updates are delivered: /digest immediate|hourly|daily HH:MM|weekly <day> HH:MM"
+
+	TimezoneCommand            = "timezone"
+	TimezoneCommandDescription = "Show or change the timezone of messages and schedules: /timezone <IANA name>"
+
+	QuietCommand            = "quiet"
+	QuietCommandDescription = "Show or change the hours updates are held at: /quiet HH:MM HH:MM|off"
+
+	UrgentCommand            = "urgent"
+	UrgentCommandDescription = "Toggle sending updates of a link during quiet hours: /urgent <link>"
+
 	SkipOption = "Skip"
+
+	// MessageLimit is the longest text of a Telegram message.
+	MessageLimit = 4096
+
+	ExpandButton   = "Show all"
+	ExpandCallback = "expand"
+
+	// The mute and untrack callbacks carry the link ID. Buttons sent before carried an ID in the
+	// callback store under "mute" and "untrack", the new names keep them from being taken for link IDs.
+	OpenButton      = "Open"
+	MuteButton      = "Mute link 24h"
+	MuteCallback    = "mutelink"
+	UntrackButton   = "Untrack"
+	UntrackCallback = "untracklink"
 )
 
 var (
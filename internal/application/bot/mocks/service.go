@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	bot "github.com/AFK068/bot/internal/application/bot"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -67,6 +68,76 @@ func (_c *Service_Run_Call) RunAndReturn(run func(context.Context) error) *Servi
 	return _c
 }
 
+// SendExpandable provides a mock function with given fields: chatID, text, details
+func (_m *Service) SendExpandable(chatID int64, text string, details string) {
+	_m.Called(chatID, text, details)
+}
+
+// Service_SendExpandable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendExpandable'
+type Service_SendExpandable_Call struct {
+	*mock.Call
+}
+
+// SendExpandable is a helper method to define mock.On call
+//   - chatID int64
+//   - text string
+//   - details string
+func (_e *Service_Expecter) SendExpandable(chatID interface{}, text interface{}, details interface{}) *Service_SendExpandable_Call {
+	return &Service_SendExpandable_Call{Call: _e.mock.On("SendExpandable", chatID, text, details)}
+}
+
+func (_c *Service_SendExpandable_Call) Run(run func(chatID int64, text string, details string)) *Service_SendExpandable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Service_SendExpandable_Call) Return() *Service_SendExpandable_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Service_SendExpandable_Call) RunAndReturn(run func(int64, string, string)) *Service_SendExpandable_Call {
+	_c.Run(run)
+	return _c
+}
+
+// SendLinkUpdate provides a mock function with given fields: chatID, text, actions
+func (_m *Service) SendLinkUpdate(chatID int64, text string, actions bot.LinkActions) {
+	_m.Called(chatID, text, actions)
+}
+
+// Service_SendLinkUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendLinkUpdate'
+type Service_SendLinkUpdate_Call struct {
+	*mock.Call
+}
+
+// SendLinkUpdate is a helper method to define mock.On call
+//   - chatID int64
+//   - text string
+//   - actions bot.LinkActions
+func (_e *Service_Expecter) SendLinkUpdate(chatID interface{}, text interface{}, actions interface{}) *Service_SendLinkUpdate_Call {
+	return &Service_SendLinkUpdate_Call{Call: _e.mock.On("SendLinkUpdate", chatID, text, actions)}
+}
+
+func (_c *Service_SendLinkUpdate_Call) Run(run func(chatID int64, text string, actions bot.LinkActions)) *Service_SendLinkUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(string), args[2].(bot.LinkActions))
+	})
+	return _c
+}
+
+func (_c *Service_SendLinkUpdate_Call) Return() *Service_SendLinkUpdate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *Service_SendLinkUpdate_Call) RunAndReturn(run func(int64, string, bot.LinkActions)) *Service_SendLinkUpdate_Call {
+	_c.Run(run)
+	return _c
+}
+
 // SendMessage provides a mock function with given fields: chatID, text, replyMarkup
 func (_m *Service) SendMessage(chatID int64, text string, replyMarkup ...interface{}) {
 	var _ca []interface{}
@@ -0,0 +1,175 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/go-co-op/gocron/v2"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+const (
+	DefaultJobDuration = time.Minute
+
+	// BatchSize is the number of due digests sent per tick.
+	BatchSize uint64 = 100
+)
+
+type timeGetter func() time.Time
+
+type Transactor interface {
+	WithTransaction(ctx context.Context, txFunc func(ctx context.Context) error) error
+}
+
+// Sender groups the buffered updates of the chats in digest mode and enqueues
+// them to the outbox once per period of the chat, outside of its quiet hours.
+type Sender struct {
+	TimeGetter timeGetter
+	scheduler  gocron.Scheduler
+	repository domain.DigestRepository
+	outbox     domain.OutboxRepository
+	transactor Transactor
+	logger     *logger.Logger
+}
+
+func NewSender(
+	repository domain.DigestRepository,
+	outbox domain.OutboxRepository,
+	transactor Transactor,
+	log *logger.Logger,
+) (*Sender, error) {
+	scheduler, err := gocron.NewScheduler()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduler: %w", err)
+	}
+
+	return &Sender{
+		TimeGetter: time.Now,
+		scheduler:  scheduler,
+		repository: repository,
+		outbox:     outbox,
+		transactor: transactor,
+		logger:     log,
+	}, nil
+}
+
+func (s *Sender) Run(jobDuration time.Duration) {
+	s.logger.Info("Starting digest sender", "jobDuration", jobDuration.String())
+
+	_, err := s.scheduler.NewJob(
+		gocron.DurationJob(
+			jobDuration,
+		),
+		gocron.NewTask(
+			s.sendTask,
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		s.logger.Error("Failed to create new job", "error", err)
+		return
+	}
+
+	s.scheduler.Start()
+	s.logger.Info("Digest sender started")
+}
+
+func (s *Sender) Stop() error {
+	if err := s.scheduler.Shutdown(); err != nil {
+		s.logger.Error("Failed to stop scheduler", "error", err)
+		return fmt.Errorf("failed to stop scheduler: %w", err)
+	}
+
+	s.logger.Info("Digest sender stopped")
+
+	return nil
+}
+
+func (s *Sender) sendTask() {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	if err := s.Send(ctx); err != nil {
+		s.logger.Error("Error sending digests", "error", err)
+	}
+}
+
+// Send enqueues one batch of due digests and schedules the next ones. The due chats
+// stay locked until the transaction ends, so every digest is sent by a single instance.
+// Each chat is sent in a nested transaction, so a failing chat is rolled back and retried
+// on the next tick while the others are committed.
+func (s *Sender) Send(ctx context.Context) error {
+	var sendErrs []error
+
+	err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		digests, err := s.repository.GetDueDigests(ctx, BatchSize)
+		if err != nil {
+			return fmt.Errorf("getting due digests: %w", err)
+		}
+
+		for _, settings := range digests {
+			err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+				return s.send(ctx, settings)
+			})
+			if err != nil {
+				sendErrs = append(sendErrs, fmt.Errorf("sending digest to chat %d: %w", settings.ChatID, err))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(sendErrs...)
+}
+
+func (s *Sender) send(ctx context.Context, settings *domain.ChatSettings) error {
+	// Digests due during the quiet hours of the chat are sent at their end.
+	if quietUntil := settings.QuietUntil(s.TimeGetter()); !quietUntil.IsZero() {
+		settings.NextDigestAt = quietUntil
+
+		if err := s.repository.UpdateNextDigest(ctx, settings); err != nil {
+			return fmt.Errorf("postponing digest: %w", err)
+		}
+
+		return nil
+	}
+
+	entries, err := s.repository.PopDigestEntries(ctx, settings.ChatID)
+	if err != nil {
+		return fmt.Errorf("popping digest entries: %w", err)
+	}
+
+	if len(entries) > 0 {
+		update := bottypes.LinkUpdate{
+			TgChatIds: &[]int64{settings.ChatID},
+			Type:      aws.String(string(domain.LinkDigest)),
+			Digest:    &entries,
+			Timezone:  aws.String(settings.Timezone),
+		}
+
+		if err := s.outbox.AddOutboxMessage(ctx, update); err != nil {
+			return fmt.Errorf("adding digest to outbox: %w", err)
+		}
+
+		s.logger.Info("Digest enqueued", "chatID", settings.ChatID, "entries", len(entries))
+	}
+
+	// Chats switched back to the immediate mode get the leftover updates once and are not scheduled again.
+	settings.NextDigestAt = settings.NextDigest(s.TimeGetter())
+
+	if err := s.repository.UpdateNextDigest(ctx, settings); err != nil {
+		return fmt.Errorf("updating next digest: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package digest_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/application/digest"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	digestMock "github.com/AFK068/bot/internal/application/digest/mocks"
+	repoMock "github.com/AFK068/bot/internal/domain/mocks"
+)
+
+func newTransactor(t *testing.T) *digestMock.Transactor {
+	transactor := digestMock.NewTransactor(t)
+
+	transactor.On("WithTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		})
+
+	return transactor
+}
+
+func Test_Send_Enqueued(t *testing.T) {
+	digests := repoMock.NewDigestRepository(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	now := time.Date(2024, time.January, 3, 10, 0, 0, 0, time.UTC)
+
+	settings := &domain.ChatSettings{ChatID: 123, DigestMode: domain.DigestHourly, NextDigestAt: now}
+	entries := []bottypes.DigestEntry{{Url: aws.String("https://github.com/test/test")}}
+
+	digests.On("GetDueDigests", mock.Anything, digest.BatchSize).Return([]*domain.ChatSettings{settings}, nil)
+	digests.On("PopDigestEntries", mock.Anything, int64(123)).Return(entries, nil)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Type == string(domain.LinkDigest) && *update.Timezone == "" &&
+			assert.ObjectsAreEqual([]int64{123}, *update.TgChatIds) && assert.ObjectsAreEqual(entries, *update.Digest)
+	})).Return(nil)
+	digests.On("UpdateNextDigest", mock.Anything, mock.MatchedBy(func(s *domain.ChatSettings) bool {
+		return s.NextDigestAt.Equal(now.Add(time.Hour))
+	})).Return(nil)
+
+	s, err := digest.NewSender(digests, outbox, newTransactor(t), logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	s.TimeGetter = func() time.Time { return now }
+
+	err = s.Send(context.Background())
+	assert.NoError(t, err)
+}
+
+func Test_Send_EmptyImmediate(t *testing.T) {
+	digests := repoMock.NewDigestRepository(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	settings := &domain.ChatSettings{ChatID: 123, DigestMode: domain.DigestImmediate, NextDigestAt: time.Now()}
+
+	digests.On("GetDueDigests", mock.Anything, digest.BatchSize).Return([]*domain.ChatSettings{settings}, nil)
+	digests.On("PopDigestEntries", mock.Anything, int64(123)).Return(nil, nil)
+	digests.On("UpdateNextDigest", mock.Anything, mock.MatchedBy(func(s *domain.ChatSettings) bool {
+		return s.NextDigestAt.IsZero()
+	})).Return(nil)
+
+	s, err := digest.NewSender(digests, outbox, newTransactor(t), logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	err = s.Send(context.Background())
+	assert.NoError(t, err)
+
+	outbox.AssertNotCalled(t, "AddOutboxMessage", mock.Anything, mock.Anything)
+}
+
+func Test_Send_OutboxFailure(t *testing.T) {
+	digests := repoMock.NewDigestRepository(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	settings := &domain.ChatSettings{ChatID: 123, DigestMode: domain.DigestDaily}
+
+	digests.On("GetDueDigests", mock.Anything, digest.BatchSize).Return([]*domain.ChatSettings{settings}, nil)
+	digests.On("PopDigestEntries", mock.Anything, int64(123)).Return([]bottypes.DigestEntry{{}}, nil)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	s, err := digest.NewSender(digests, outbox, newTransactor(t), logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	err = s.Send(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+
+	digests.AssertNotCalled(t, "UpdateNextDigest", mock.Anything, mock.Anything)
+}
+
+func Test_Send_FailedChatSkipped(t *testing.T) {
+	digests := repoMock.NewDigestRepository(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	now := time.Date(2024, time.January, 3, 10, 0, 0, 0, time.UTC)
+
+	failing := &domain.ChatSettings{ChatID: 123, DigestMode: domain.DigestHourly, NextDigestAt: now}
+	sent := &domain.ChatSettings{ChatID: 456, DigestMode: domain.DigestHourly, NextDigestAt: now}
+
+	digests.On("GetDueDigests", mock.Anything, digest.BatchSize).Return([]*domain.ChatSettings{failing, sent}, nil)
+	digests.On("PopDigestEntries", mock.Anything, mock.Anything).Return([]bottypes.DigestEntry{{}}, nil)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return (*update.TgChatIds)[0] == 123
+	})).Return(assert.AnError)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return (*update.TgChatIds)[0] == 456
+	})).Return(nil)
+	digests.On("UpdateNextDigest", mock.Anything, sent).Return(nil)
+
+	transactor := newTransactor(t)
+
+	s, err := digest.NewSender(digests, outbox, transactor, logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	s.TimeGetter = func() time.Time { return now }
+
+	err = s.Send(context.Background())
+	assert.ErrorIs(t, err, assert.AnError)
+
+	// The batch and every chat get their own transaction.
+	transactor.AssertNumberOfCalls(t, "WithTransaction", 3)
+	digests.AssertNotCalled(t, "UpdateNextDigest", mock.Anything, failing)
+}
+
+func Test_Send_PostponedByQuietHours(t *testing.T) {
+	digests := repoMock.NewDigestRepository(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	now := time.Date(2024, time.January, 3, 23, 0, 0, 0, time.UTC)
+
+	settings := &domain.ChatSettings{
+		ChatID:     123,
+		DigestMode: domain.DigestHourly,
+		Timezone:   "UTC",
+		QuietStart: 22 * time.Hour,
+		QuietEnd:   7 * time.Hour,
+	}
+
+	digests.On("GetDueDigests", mock.Anything, digest.BatchSize).Return([]*domain.ChatSettings{settings}, nil)
+	digests.On("UpdateNextDigest", mock.Anything, mock.MatchedBy(func(s *domain.ChatSettings) bool {
+		return s.NextDigestAt.Equal(time.Date(2024, time.January, 4, 7, 0, 0, 0, time.UTC))
+	})).Return(nil)
+
+	s, err := digest.NewSender(digests, outbox, newTransactor(t), logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	s.TimeGetter = func() time.Time { return now }
+
+	err = s.Send(context.Background())
+	assert.NoError(t, err)
+
+	digests.AssertNotCalled(t, "PopDigestEntries", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,162 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-co-op/gocron/v2"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/clients/bot"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+)
+
+const (
+	DefaultJobDuration = 5 * time.Second
+
+	// BatchSize is the number of due messages delivered per tick.
+	BatchSize uint64 = 100
+	// ClaimLease is how long claimed messages are hidden from other scrapper instances,
+	// it outlasts the delivery of a batch.
+	ClaimLease = time.Minute
+	// MaxAttempts is the number of failed deliveries after which a message becomes dead.
+	MaxAttempts = 8
+
+	BaseBackoff = 10 * time.Second
+	MaxBackoff  = time.Hour
+)
+
+type timeGetter func() time.Time
+
+// Dispatcher delivers link updates from the outbox to the bot.
+type Dispatcher struct {
+	TimeGetter timeGetter
+	scheduler  gocron.Scheduler
+	repository domain.OutboxRepository
+	botClient  bot.Service
+	logger     *logger.Logger
+}
+
+func NewDispatcher(repository domain.OutboxRepository, botClient bot.Service, log *logger.Logger) (*Dispatcher, error) {
+	scheduler, err := gocron.NewScheduler()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create scheduler: %w", err)
+	}
+
+	return &Dispatcher{
+		TimeGetter: time.Now,
+		scheduler:  scheduler,
+		repository: repository,
+		botClient:  botClient,
+		logger:     log,
+	}, nil
+}
+
+func (d *Dispatcher) Run(jobDuration time.Duration) {
+	d.logger.Info("Starting outbox dispatcher", "jobDuration", jobDuration.String())
+
+	_, err := d.scheduler.NewJob(
+		gocron.DurationJob(
+			jobDuration,
+		),
+		gocron.NewTask(
+			d.dispatchTask,
+		),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+	if err != nil {
+		d.logger.Error("Failed to create new job", "error", err)
+		return
+	}
+
+	d.scheduler.Start()
+	d.logger.Info("Outbox dispatcher started")
+}
+
+func (d *Dispatcher) Stop() error {
+	if err := d.scheduler.Shutdown(); err != nil {
+		d.logger.Error("Failed to stop scheduler", "error", err)
+		return fmt.Errorf("failed to stop scheduler: %w", err)
+	}
+
+	d.logger.Info("Outbox dispatcher stopped")
+
+	return nil
+}
+
+func (d *Dispatcher) dispatchTask() {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	if err := d.Dispatch(ctx); err != nil {
+		d.logger.Error("Error dispatching outbox messages", "error", err)
+	}
+}
+
+// Dispatch claims and delivers one batch of due messages. Delivered messages are deleted,
+// messages rejected as invalid become dead at once, other failures are retried with
+// exponential backoff until MaxAttempts is reached.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	messages, err := d.repository.ClaimOutboxMessages(ctx, BatchSize, ClaimLease)
+	if err != nil {
+		return fmt.Errorf("claiming due outbox messages: %w", err)
+	}
+
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if err := d.deliver(ctx, message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, message *domain.OutboxMessage) error {
+	postErr := d.botClient.PostUpdates(ctx, message.Update)
+	if postErr == nil {
+		if err := d.repository.DeleteOutboxMessage(ctx, message.ID); err != nil {
+			return fmt.Errorf("deleting delivered outbox message: %w", err)
+		}
+
+		return nil
+	}
+
+	message.Attempts++
+	message.LastError = postErr.Error()
+
+	// Updates rejected by the bot as invalid cannot be delivered by retrying them.
+	var invalidErr *bot.InvalidUpdateError
+
+	if errors.As(postErr, &invalidErr) || message.Attempts >= MaxAttempts {
+		message.Status = domain.OutboxStatusDead
+
+		d.logger.Error("Outbox message is dead", "id", message.ID, "attempts", message.Attempts, "error", postErr)
+	} else {
+		message.NextAttemptAt = d.TimeGetter().Add(Backoff(message.Attempts))
+
+		d.logger.Warn("Failed to deliver outbox message", "id", message.ID, "attempts", message.Attempts, "error", postErr)
+	}
+
+	if err := d.repository.UpdateOutboxMessage(ctx, message); err != nil {
+		return fmt.Errorf("updating outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts.
+func Backoff(attempts int) time.Duration {
+	backoff := BaseBackoff
+
+	for i := 1; i < attempts && backoff < MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, MaxBackoff)
+}
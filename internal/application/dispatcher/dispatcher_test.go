@@ -0,0 +1,124 @@
+package dispatcher_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/application/dispatcher"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/clients/bot"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	repoMock "github.com/AFK068/bot/internal/domain/mocks"
+	botMock "github.com/AFK068/bot/internal/infrastructure/clients/bot/mocks"
+)
+
+func Test_Dispatch_Delivered(t *testing.T) {
+	outbox := repoMock.NewOutboxRepository(t)
+	botClient := botMock.NewService(t)
+
+	message := &domain.OutboxMessage{
+		ID:     1,
+		Update: bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")},
+		Status: domain.OutboxStatusPending,
+	}
+
+	outbox.On("ClaimOutboxMessages", mock.Anything, dispatcher.BatchSize, dispatcher.ClaimLease).Return([]*domain.OutboxMessage{message}, nil)
+	botClient.On("PostUpdates", mock.Anything, message.Update).Return(nil)
+	outbox.On("DeleteOutboxMessage", mock.Anything, int64(1)).Return(nil)
+
+	d, err := dispatcher.NewDispatcher(outbox, botClient, logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	err = d.Dispatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func Test_Dispatch_Retry(t *testing.T) {
+	outbox := repoMock.NewOutboxRepository(t)
+	botClient := botMock.NewService(t)
+
+	now := time.Now()
+
+	message := &domain.OutboxMessage{
+		ID:       1,
+		Status:   domain.OutboxStatusPending,
+		Attempts: 2,
+	}
+
+	outbox.On("ClaimOutboxMessages", mock.Anything, dispatcher.BatchSize, dispatcher.ClaimLease).Return([]*domain.OutboxMessage{message}, nil)
+	botClient.On("PostUpdates", mock.Anything, message.Update).Return(assert.AnError)
+	outbox.On("UpdateOutboxMessage", mock.Anything, mock.MatchedBy(func(m *domain.OutboxMessage) bool {
+		return m.Attempts == 3 && m.Status == domain.OutboxStatusPending &&
+			m.NextAttemptAt.Equal(now.Add(dispatcher.Backoff(3))) && m.LastError == assert.AnError.Error()
+	})).Return(nil)
+
+	d, err := dispatcher.NewDispatcher(outbox, botClient, logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	d.TimeGetter = func() time.Time { return now }
+
+	err = d.Dispatch(context.Background())
+	assert.NoError(t, err)
+
+	outbox.AssertNotCalled(t, "DeleteOutboxMessage", mock.Anything, mock.Anything)
+}
+
+func Test_Dispatch_DeadLetter(t *testing.T) {
+	outbox := repoMock.NewOutboxRepository(t)
+	botClient := botMock.NewService(t)
+
+	message := &domain.OutboxMessage{
+		ID:       1,
+		Status:   domain.OutboxStatusPending,
+		Attempts: dispatcher.MaxAttempts - 1,
+	}
+
+	outbox.On("ClaimOutboxMessages", mock.Anything, dispatcher.BatchSize, dispatcher.ClaimLease).Return([]*domain.OutboxMessage{message}, nil)
+	botClient.On("PostUpdates", mock.Anything, message.Update).Return(assert.AnError)
+	outbox.On("UpdateOutboxMessage", mock.Anything, mock.MatchedBy(func(m *domain.OutboxMessage) bool {
+		return m.Attempts == dispatcher.MaxAttempts && m.Status == domain.OutboxStatusDead
+	})).Return(nil)
+
+	d, err := dispatcher.NewDispatcher(outbox, botClient, logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	err = d.Dispatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func Test_Dispatch_InvalidUpdate(t *testing.T) {
+	outbox := repoMock.NewOutboxRepository(t)
+	botClient := botMock.NewService(t)
+
+	message := &domain.OutboxMessage{
+		ID:     1,
+		Status: domain.OutboxStatusPending,
+	}
+
+	outbox.On("ClaimOutboxMessages", mock.Anything, dispatcher.BatchSize, dispatcher.ClaimLease).Return([]*domain.OutboxMessage{message}, nil)
+	botClient.On("PostUpdates", mock.Anything, message.Update).Return(&bot.InvalidUpdateError{Description: "Link is empty"})
+	outbox.On("UpdateOutboxMessage", mock.Anything, mock.MatchedBy(func(m *domain.OutboxMessage) bool {
+		return m.Attempts == 1 && m.Status == domain.OutboxStatusDead && m.LastError == "bad request: Link is empty"
+	})).Return(nil)
+
+	d, err := dispatcher.NewDispatcher(outbox, botClient, logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	err = d.Dispatch(context.Background())
+	assert.NoError(t, err)
+}
+
+func Test_Backoff(t *testing.T) {
+	assert.Equal(t, dispatcher.BaseBackoff, dispatcher.Backoff(1))
+	assert.Equal(t, 2*dispatcher.BaseBackoff, dispatcher.Backoff(2))
+	assert.Equal(t, 8*dispatcher.BaseBackoff, dispatcher.Backoff(4))
+	assert.Equal(t, dispatcher.MaxBackoff, dispatcher.Backoff(100))
+}
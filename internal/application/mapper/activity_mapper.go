@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/AFK068/bot/internal/application/provider"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+)
+
+// DefaultActivitiesLimit is the number of activities returned when no limit is given.
+const DefaultActivitiesLimit int64 = 20
+
+// MapActivityQueryToDomain validates the history query parameters. The activity type is given
+// with or without the provider prefix and selects every registered type it matches.
+func MapActivityQueryToDomain(
+	providers *provider.Registry,
+	since *time.Time,
+	activityType *string,
+	limit *int64,
+) (domain.ActivityQuery, error) {
+	query := domain.ActivityQuery{
+		Limit: uint64(DefaultActivitiesLimit),
+	}
+
+	if since != nil {
+		query.Since = *since
+	}
+
+	if activityType != nil && *activityType != "" {
+		query.Types = domain.MatchActivityTypes(providers.ActivityTypes(), *activityType)
+		if len(query.Types) == 0 {
+			return domain.ActivityQuery{}, &apperrors.ActivityQueryValidateError{Message: "unknown activity type"}
+		}
+	}
+
+	if limit != nil {
+		if *limit <= 0 {
+			return domain.ActivityQuery{}, &apperrors.ActivityQueryValidateError{Message: "limit must be positive"}
+		}
+
+		query.Limit = uint64(*limit)
+	}
+
+	return query, nil
+}
@@ -0,0 +1,51 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+)
+
+func Test_MapActivityQueryToDomain_Success(t *testing.T) {
+	since := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	query, err := mapper.MapActivityQueryToDomain(newRegistry(t), &since, aws.String("answer"), aws.Int64(5))
+	require.NoError(t, err)
+	assert.Equal(t, domain.ActivityQuery{
+		Since: since,
+		Types: []domain.ActivityType{domain.StackoverflowAnswer},
+		Limit: 5,
+	}, query)
+
+	query, err = mapper.MapActivityQueryToDomain(newRegistry(t), nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ActivityQuery{Limit: uint64(mapper.DefaultActivitiesLimit)}, query)
+}
+
+func Test_MapActivityQueryToDomain_Failure(t *testing.T) {
+	tests := []struct {
+		name         string
+		activityType *string
+		limit        *int64
+	}{
+		{name: "Unknown type", activityType: aws.String("wiki")},
+		{name: "Zero limit", limit: aws.Int64(0)},
+		{name: "Negative limit", limit: aws.Int64(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := mapper.MapActivityQueryToDomain(newRegistry(t), nil, tt.activityType, tt.limit)
+
+			var validateErr *apperrors.ActivityQueryValidateError
+			assert.ErrorAs(t, err, &validateErr)
+		})
+	}
+}
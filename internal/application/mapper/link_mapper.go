@@ -1,16 +1,23 @@
 package mapper
 
 import (
-	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/domain/apperrors"
 
 	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
 )
 
-func MapAddLinkRequestToDomain(tgChatID int64, addLinkRequest *scrappertypes.AddLinkRequest) (*domain.Link, error) {
+// MapAddLinkRequestToDomain validates the request and maps it to a link of the provider matching its URL.
+func MapAddLinkRequestToDomain(
+	providers *provider.Registry,
+	tgChatID int64,
+	addLinkRequest *scrappertypes.AddLinkRequest,
+) (*domain.Link, error) {
 	if addLinkRequest.Link == nil || *addLinkRequest.Link == "" {
 		return nil, &apperrors.LinkValidateError{Message: "link is required"}
 	}
@@ -24,20 +31,35 @@ func MapAddLinkRequestToDomain(tgChatID int64, addLinkRequest *scrappertypes.Add
 	}
 
 	if addLinkRequest.Filters != nil {
+		if _, err := domain.ParseFilters(*addLinkRequest.Filters, providers.ActivityTypes()); err != nil {
+			return nil, err
+		}
+
 		link.Filters = *addLinkRequest.Filters
 	}
 
+	if addLinkRequest.CheckInterval != nil {
+		if *addLinkRequest.CheckInterval < 0 {
+			return nil, &apperrors.LinkValidateError{Message: "check interval must not be negative"}
+		}
+
+		link.MaxCheckInterval = time.Duration(*addLinkRequest.CheckInterval) * time.Second
+	}
+
+	link.Urgent = aws.BoolValue(addLinkRequest.Urgent)
 	link.UserAddID = tgChatID
 
-	switch {
-	case strings.HasPrefix(*addLinkRequest.Link, "https://stackoverflow.com"):
-		link.Type = domain.StackoverflowType
-	case strings.HasPrefix(*addLinkRequest.Link, "https://github.com"):
-		link.Type = domain.GithubType
-	default:
+	linkProvider, ok := providers.Match(link.URL)
+	if !ok {
 		return nil, &apperrors.LinkTypeError{Message: "unsupported link type"}
 	}
 
+	link.Type = linkProvider.Type()
+
+	if err := linkProvider.Normalize(link); err != nil {
+		return nil, &apperrors.LinkValidateError{Message: err.Error()}
+	}
+
 	link.LastCheck = time.Now()
 
 	return link, nil
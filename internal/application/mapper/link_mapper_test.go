@@ -6,15 +6,30 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
 
 	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+	providerMock "github.com/AFK068/bot/internal/application/provider/mocks"
 )
 
+// newRegistry returns the built-in providers, their clients are not used by the mapper.
+func newRegistry(t *testing.T) *provider.Registry {
+	registry, err := provider.NewRegistry(
+		provider.NewGitHubProvider(nil, logger.NewDiscardLogger()),
+		provider.NewStackOverflowProvider(nil, logger.NewDiscardLogger()),
+	)
+	require.NoError(t, err)
+
+	return registry
+}
+
 func Test_MapAddLinkRequestToDomain_Success(t *testing.T) {
 	type args struct {
 		userID  int64
@@ -31,7 +46,7 @@ func Test_MapAddLinkRequestToDomain_Success(t *testing.T) {
 			args: args{
 				userID: 1,
 				request: &scrappertypes.AddLinkRequest{
-					Link:    aws.String("https://github.com/test"),
+					Link:    aws.String("https://github.com/test/test"),
 					Tags:    &[]string{"tag"},
 					Filters: &[]string{"filter"},
 				},
@@ -50,12 +65,45 @@ func Test_MapAddLinkRequestToDomain_Success(t *testing.T) {
 			},
 			wantType: domain.StackoverflowType,
 		},
+		{
+			name: "Filters success",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link:    aws.String("https://github.com/test/test"),
+					Filters: &[]string{"user:octocat", "-user:dependabot", "type:pull_request", "label:bug", "text:~^fix"},
+				},
+			},
+			wantType: domain.GithubType,
+		},
+		{
+			name: "Check interval success",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link:          aws.String("https://github.com/test/test"),
+					CheckInterval: aws.Int64(600),
+				},
+			},
+			wantType: domain.GithubType,
+		},
+		{
+			name: "Urgent success",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link:   aws.String("https://github.com/test/test"),
+					Urgent: aws.Bool(true),
+				},
+			},
+			wantType: domain.GithubType,
+		},
 		{
 			name: "LastCheck set correctly",
 			args: args{
 				userID: 1,
 				request: &scrappertypes.AddLinkRequest{
-					Link: aws.String("https://github.com/test"),
+					Link: aws.String("https://github.com/test/test"),
 				},
 			},
 			wantType: domain.GithubType,
@@ -64,7 +112,7 @@ func Test_MapAddLinkRequestToDomain_Success(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			link, err := mapper.MapAddLinkRequestToDomain(tt.args.userID, tt.args.request)
+			link, err := mapper.MapAddLinkRequestToDomain(newRegistry(t), tt.args.userID, tt.args.request)
 
 			require.NoError(t, err)
 			require.NotNil(t, link)
@@ -79,6 +127,11 @@ func Test_MapAddLinkRequestToDomain_Success(t *testing.T) {
 				assert.Equal(t, *tt.args.request.Filters, link.Filters)
 			}
 
+			if tt.args.request.CheckInterval != nil {
+				assert.Equal(t, time.Duration(*tt.args.request.CheckInterval)*time.Second, link.MaxCheckInterval)
+			}
+
+			assert.Equal(t, aws.BoolValue(tt.args.request.Urgent), link.Urgent)
 			assert.Equal(t, *tt.args.request.Link, link.URL)
 			assert.Equal(t, tt.wantType, link.Type)
 
@@ -87,6 +140,52 @@ func Test_MapAddLinkRequestToDomain_Success(t *testing.T) {
 	}
 }
 
+func Test_MapAddLinkRequestToDomain_StackExchangeSite(t *testing.T) {
+	tests := []struct {
+		link     string
+		wantSite string
+	}{
+		{link: "https://stackoverflow.com/questions/1", wantSite: "stackoverflow.com"},
+		{link: "https://ru.stackoverflow.com/questions/1", wantSite: "ru.stackoverflow.com"},
+		{link: "https://serverfault.com/questions/1", wantSite: "serverfault.com"},
+		{link: "https://superuser.com/questions/1", wantSite: "superuser.com"},
+		{link: "https://askubuntu.com/questions/1", wantSite: "askubuntu.com"},
+		{link: "https://unix.stackexchange.com/questions/1", wantSite: "unix.stackexchange.com"},
+		{link: "https://meta.stackoverflow.com/questions/1", wantSite: "meta.stackoverflow.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.link, func(t *testing.T) {
+			link, err := mapper.MapAddLinkRequestToDomain(newRegistry(t), 1, &scrappertypes.AddLinkRequest{Link: aws.String(tt.link)})
+			require.NoError(t, err)
+
+			assert.Equal(t, domain.StackoverflowType, link.Type)
+			assert.Equal(t, tt.wantSite, link.Metadata.Site)
+		})
+	}
+}
+
+func Test_MapAddLinkRequestToDomain_RegisteredProvider(t *testing.T) {
+	gitlab := providerMock.NewProvider(t)
+
+	gitlab.On("Match", "https://gitlab.com/test/test").Return(true)
+	gitlab.On("Type").Return("gitlab")
+	gitlab.On("Normalize", mock.Anything).Return(nil)
+	gitlab.On("ActivityKinds").Return([]domain.ActivityKind{{Type: "gitlab_merge_request"}})
+
+	registry, err := provider.NewRegistry(gitlab)
+	require.NoError(t, err)
+
+	link, err := mapper.MapAddLinkRequestToDomain(registry, 1, &scrappertypes.AddLinkRequest{
+		Link:    aws.String("https://gitlab.com/test/test"),
+		Filters: &[]string{"type:merge_request"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "gitlab", link.Type)
+	assert.Equal(t, []string{"type:merge_request"}, link.Filters)
+}
+
 func Test_MapAddLinkRequestToDomain_Failure(t *testing.T) {
 	type args struct {
 		userID  int64
@@ -119,11 +218,91 @@ func Test_MapAddLinkRequestToDomain_Failure(t *testing.T) {
 			expectErr: true,
 			errType:   &apperrors.LinkTypeError{},
 		},
+		{
+			name: "Look-alike Stack Exchange host failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link: aws.String("https://stackexchange.com.example.org/questions/1"),
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.LinkTypeError{},
+		},
+		{
+			name: "Look-alike GitHub host failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link: aws.String("https://github.com.evil.com/owner/repo"),
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.LinkTypeError{},
+		},
+		{
+			name: "Malformed GitHub link failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link: aws.String("https://github.com/owner"),
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.LinkValidateError{},
+		},
+		{
+			name: "Unsupported GitHub path failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link: aws.String("https://github.com/owner/repo/blob/main/README.md"),
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.LinkValidateError{},
+		},
+		{
+			name: "Negative check interval failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link:          aws.String("https://github.com/test/test"),
+					CheckInterval: aws.Int64(-1),
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.LinkValidateError{},
+		},
+		{
+			name: "Unknown filter key failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link:    aws.String("https://github.com/test/test"),
+					Filters: &[]string{"author:octocat"},
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.FilterValidateError{},
+		},
+		{
+			name: "Invalid filter regexp failure",
+			args: args{
+				userID: 1,
+				request: &scrappertypes.AddLinkRequest{
+					Link:    aws.String("https://github.com/test/test"),
+					Filters: &[]string{"text:~(unclosed"},
+				},
+			},
+			expectErr: true,
+			errType:   &apperrors.FilterValidateError{},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			link, err := mapper.MapAddLinkRequestToDomain(tt.args.userID, tt.args.request)
+			link, err := mapper.MapAddLinkRequestToDomain(newRegistry(t), tt.args.userID, tt.args.request)
 
 			require.Error(t, err)
 			require.Nil(t, link)
@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+)
+
+// MapChatSettingsToDomain validates the delivery settings of the chat and schedules its next digest.
+// The digest time is required by daily and weekly digests and the weekday by weekly ones.
+// Switching to the immediate mode schedules the buffered updates to be sent right away.
+// The timezone defaults to UTC and the quiet hours are off unless both their start and end are set.
+func MapChatSettingsToDomain(chatID int64, req *scrappertypes.ChatSettings, now time.Time) (*domain.ChatSettings, error) {
+	if req.DigestMode == nil {
+		return nil, &apperrors.SettingsValidateError{Message: "digest mode is required"}
+	}
+
+	mode, err := domain.ParseDigestMode(*req.DigestMode)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := domain.DefaultChatSettings(chatID)
+	settings.DigestMode = mode
+
+	if mode == domain.DigestDaily || mode == domain.DigestWeekly {
+		if req.DigestTime == nil {
+			return nil, &apperrors.SettingsValidateError{Message: "digest time is required"}
+		}
+
+		if settings.DigestTime, err = domain.ParseDigestTime(*req.DigestTime); err != nil {
+			return nil, err
+		}
+	}
+
+	if mode == domain.DigestWeekly {
+		if req.DigestWeekday == nil {
+			return nil, &apperrors.SettingsValidateError{Message: "digest weekday is required"}
+		}
+
+		if settings.DigestWeekday, err = domain.ParseWeekday(*req.DigestWeekday); err != nil {
+			return nil, err
+		}
+	}
+
+	if aws.StringValue(req.Timezone) != "" {
+		if settings.Timezone, err = domain.ParseTimezone(*req.Timezone); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mapQuietHours(settings, aws.StringValue(req.QuietStart), aws.StringValue(req.QuietEnd)); err != nil {
+		return nil, err
+	}
+
+	settings.NextDigestAt = settings.NextDigest(now)
+	if !settings.Digest() {
+		settings.NextDigestAt = now
+	}
+
+	return settings, nil
+}
+
+func mapQuietHours(settings *domain.ChatSettings, quietStart, quietEnd string) error {
+	if quietStart == "" && quietEnd == "" {
+		return nil
+	}
+
+	if quietStart == "" || quietEnd == "" {
+		return &apperrors.SettingsValidateError{Message: "quiet hours require both start and end"}
+	}
+
+	var err error
+
+	if settings.QuietStart, err = domain.ParseDigestTime(quietStart); err != nil {
+		return err
+	}
+
+	if settings.QuietEnd, err = domain.ParseDigestTime(quietEnd); err != nil {
+		return err
+	}
+
+	if !settings.Quiet() {
+		return &apperrors.SettingsValidateError{Message: "quiet hours must not start and end at the same time"}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package mapper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+)
+
+func Test_MapChatSettingsToDomain_Success(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+
+	settings, err := mapper.MapChatSettingsToDomain(123, &scrappertypes.ChatSettings{
+		DigestMode:    aws.String("weekly"),
+		DigestTime:    aws.String("09:00"),
+		DigestWeekday: aws.String("friday"),
+	}, now)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.ChatSettings{
+		ChatID:        123,
+		DigestMode:    domain.DigestWeekly,
+		DigestTime:    9 * time.Hour,
+		DigestWeekday: time.Friday,
+		NextDigestAt:  time.Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC),
+		Timezone:      "UTC",
+	}, settings)
+
+	settings, err = mapper.MapChatSettingsToDomain(123, &scrappertypes.ChatSettings{DigestMode: aws.String("immediate")}, now)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DigestImmediate, settings.DigestMode)
+	assert.Equal(t, now, settings.NextDigestAt)
+}
+
+func Test_MapChatSettingsToDomain_TimezoneAndQuietHours(t *testing.T) {
+	now := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+
+	settings, err := mapper.MapChatSettingsToDomain(123, &scrappertypes.ChatSettings{
+		DigestMode: aws.String("daily"),
+		DigestTime: aws.String("09:00"),
+		Timezone:   aws.String("Europe/Berlin"),
+		QuietStart: aws.String("23:00"),
+		QuietEnd:   aws.String("07:30"),
+	}, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Europe/Berlin", settings.Timezone)
+	assert.Equal(t, 23*time.Hour, settings.QuietStart)
+	assert.Equal(t, 7*time.Hour+30*time.Minute, settings.QuietEnd)
+	// 09:00 in Berlin is 08:00 UTC in winter.
+	assert.Equal(t, time.Date(2024, time.January, 4, 8, 0, 0, 0, time.UTC), settings.NextDigestAt)
+}
+
+func Test_MapChatSettingsToDomain_Failure(t *testing.T) {
+	tests := []struct {
+		name string
+		req  scrappertypes.ChatSettings
+	}{
+		{name: "Missing mode"},
+		{name: "Unknown mode", req: scrappertypes.ChatSettings{DigestMode: aws.String("monthly")}},
+		{name: "Missing time", req: scrappertypes.ChatSettings{DigestMode: aws.String("daily")}},
+		{name: "Invalid time", req: scrappertypes.ChatSettings{DigestMode: aws.String("daily"), DigestTime: aws.String("9am")}},
+		{
+			name: "Missing weekday",
+			req:  scrappertypes.ChatSettings{DigestMode: aws.String("weekly"), DigestTime: aws.String("09:00")},
+		},
+		{
+			name: "Invalid weekday",
+			req: scrappertypes.ChatSettings{
+				DigestMode:    aws.String("weekly"),
+				DigestTime:    aws.String("09:00"),
+				DigestWeekday: aws.String("x"),
+			},
+		},
+		{
+			name: "Unknown timezone",
+			req:  scrappertypes.ChatSettings{DigestMode: aws.String("immediate"), Timezone: aws.String("Mars/Olympus")},
+		},
+		{
+			name: "Missing quiet end",
+			req:  scrappertypes.ChatSettings{DigestMode: aws.String("immediate"), QuietStart: aws.String("23:00")},
+		},
+		{
+			name: "Invalid quiet start",
+			req: scrappertypes.ChatSettings{
+				DigestMode: aws.String("immediate"),
+				QuietStart: aws.String("late"),
+				QuietEnd:   aws.String("07:00"),
+			},
+		},
+		{
+			name: "Empty quiet hours",
+			req: scrappertypes.ChatSettings{
+				DigestMode: aws.String("immediate"),
+				QuietStart: aws.String("07:00"),
+				QuietEnd:   aws.String("07:00"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := mapper.MapChatSettingsToDomain(123, &tt.req, time.Now())
+
+			var settingsValidateErr *apperrors.SettingsValidateError
+			assert.ErrorAs(t, err, &settingsValidateErr)
+		})
+	}
+}
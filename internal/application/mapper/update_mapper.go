@@ -0,0 +1,182 @@
+package mapper
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	botgrpc "github.com/AFK068/bot/internal/api/grpc/bot/v1"
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+// MapLinkUpdateToProto converts the update for delivery over gRPC. Missing fields become zero values.
+func MapLinkUpdateToProto(update bottypes.LinkUpdate) *botgrpc.LinkUpdate {
+	message := &botgrpc.LinkUpdate{
+		Id:          aws.Int64Value(update.Id),
+		Url:         aws.StringValue(update.Url),
+		Description: aws.StringValue(update.Description),
+		UserName:    aws.StringValue(update.UserName),
+		Timezone:    aws.StringValue(update.Timezone),
+		Title:       aws.StringValue(update.Title),
+		ActivityUrl: aws.StringValue(update.ActivityUrl),
+		Emoji:       aws.StringValue(update.Emoji),
+		Noun:        aws.StringValue(update.Noun),
+	}
+
+	if update.Type != nil {
+		message.Type = string(*update.Type)
+	}
+
+	if update.СreatedAt != nil {
+		message.CreatedAt = timestamppb.New(*update.СreatedAt)
+	}
+
+	if update.TgChatIds != nil {
+		message.TgChatIds = *update.TgChatIds
+	}
+
+	if update.Digest != nil {
+		for _, entry := range *update.Digest {
+			message.Digest = append(message.Digest, mapDigestEntryToProto(entry))
+		}
+	}
+
+	if update.Batch != nil {
+		for _, entry := range *update.Batch {
+			message.Batch = append(message.Batch, mapDigestEntryToProto(entry))
+		}
+	}
+
+	return message
+}
+
+func mapDigestEntryToProto(entry bottypes.DigestEntry) *botgrpc.DigestEntry {
+	message := &botgrpc.DigestEntry{
+		Url:         aws.StringValue(entry.Url),
+		Description: aws.StringValue(entry.Description),
+		UserName:    aws.StringValue(entry.UserName),
+		Noun:        aws.StringValue(entry.Noun),
+	}
+
+	if entry.Type != nil {
+		message.Type = string(*entry.Type)
+	}
+
+	if entry.CreatedAt != nil {
+		message.CreatedAt = timestamppb.New(*entry.CreatedAt)
+	}
+
+	if entry.Tags != nil {
+		message.Tags = *entry.Tags
+	}
+
+	return message
+}
+
+// MapProtoToLinkUpdate converts the update received over gRPC. Zero values become missing fields.
+func MapProtoToLinkUpdate(message *botgrpc.LinkUpdate) bottypes.LinkUpdate {
+	var update bottypes.LinkUpdate
+
+	if message.GetId() != 0 {
+		update.Id = aws.Int64(message.GetId())
+	}
+
+	if message.GetUrl() != "" {
+		update.Url = aws.String(message.GetUrl())
+	}
+
+	if message.GetDescription() != "" {
+		update.Description = aws.String(message.GetDescription())
+	}
+
+	if message.GetUserName() != "" {
+		update.UserName = aws.String(message.GetUserName())
+	}
+
+	if message.GetType() != "" {
+		updateType := bottypes.LinkUpdateType(message.GetType())
+		update.Type = &updateType
+	}
+
+	if message.GetCreatedAt() != nil {
+		update.СreatedAt = aws.Time(message.GetCreatedAt().AsTime())
+	}
+
+	if len(message.GetTgChatIds()) > 0 {
+		update.TgChatIds = &message.TgChatIds
+	}
+
+	if message.GetTimezone() != "" {
+		update.Timezone = aws.String(message.GetTimezone())
+	}
+
+	if message.GetTitle() != "" {
+		update.Title = aws.String(message.GetTitle())
+	}
+
+	if message.GetActivityUrl() != "" {
+		update.ActivityUrl = aws.String(message.GetActivityUrl())
+	}
+
+	if message.GetEmoji() != "" {
+		update.Emoji = aws.String(message.GetEmoji())
+	}
+
+	if message.GetNoun() != "" {
+		update.Noun = aws.String(message.GetNoun())
+	}
+
+	if len(message.GetDigest()) > 0 {
+		digest := make([]bottypes.DigestEntry, len(message.GetDigest()))
+		for i, entry := range message.GetDigest() {
+			digest[i] = mapProtoToDigestEntry(entry)
+		}
+
+		update.Digest = &digest
+	}
+
+	if len(message.GetBatch()) > 0 {
+		batch := make([]bottypes.DigestEntry, len(message.GetBatch()))
+		for i, entry := range message.GetBatch() {
+			batch[i] = mapProtoToDigestEntry(entry)
+		}
+
+		update.Batch = &batch
+	}
+
+	return update
+}
+
+func mapProtoToDigestEntry(message *botgrpc.DigestEntry) bottypes.DigestEntry {
+	var entry bottypes.DigestEntry
+
+	if message.GetUrl() != "" {
+		entry.Url = aws.String(message.GetUrl())
+	}
+
+	if message.GetDescription() != "" {
+		entry.Description = aws.String(message.GetDescription())
+	}
+
+	if message.GetUserName() != "" {
+		entry.UserName = aws.String(message.GetUserName())
+	}
+
+	if message.GetNoun() != "" {
+		entry.Noun = aws.String(message.GetNoun())
+	}
+
+	if message.GetType() != "" {
+		entryType := bottypes.LinkUpdateType(message.GetType())
+		entry.Type = &entryType
+	}
+
+	if message.GetCreatedAt() != nil {
+		entry.CreatedAt = aws.Time(message.GetCreatedAt().AsTime())
+	}
+
+	if len(message.GetTags()) > 0 {
+		entry.Tags = &message.Tags
+	}
+
+	return entry
+}
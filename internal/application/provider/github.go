@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/pkg/client/github"
+)
+
+const gitHubHost = "github.com"
+
+type GitHubRepoFetcher interface {
+	GetRepo(ctx context.Context, questionURL string, validators github.Validators) (*github.Repository, error)
+	GetActivity(
+		ctx context.Context,
+		repository *github.Repository,
+		lastCheckTime time.Time,
+		validators github.Validators,
+	) ([]*github.Activity, error)
+	GetThreadActivity(
+		ctx context.Context,
+		threadURL string,
+		lastCheckTime time.Time,
+		validators github.Validators,
+	) ([]*github.Activity, error)
+	GetReleaseActivity(
+		ctx context.Context,
+		releasesURL string,
+		lastCheckTime time.Time,
+		validators github.Validators,
+	) ([]*github.Activity, error)
+	GetTagActivity(
+		ctx context.Context,
+		tagsURL string,
+		lastCheckTime time.Time,
+		validators github.Validators,
+	) ([]*github.Activity, error)
+	GetCommitActivity(
+		ctx context.Context,
+		branchURL string,
+		lastCheckTime time.Time,
+		validators github.Validators,
+	) ([]*github.Activity, error)
+	RateLimit() github.RateLimit
+}
+
+// GitHubProvider tracks GitHub repositories, issues, pull requests, releases, tags and branches.
+// Every link is fetched on its own with conditional requests.
+type GitHubProvider struct {
+	client GitHubRepoFetcher
+	logger *logger.Logger
+}
+
+func NewGitHubProvider(client GitHubRepoFetcher, log *logger.Logger) *GitHubProvider {
+	return &GitHubProvider{
+		client: client,
+		logger: log,
+	}
+}
+
+func (p *GitHubProvider) Type() string {
+	return domain.GithubType
+}
+
+// Match accepts HTTPS links of the github.com host, hosts that only start with it are not matched.
+func (p *GitHubProvider) Match(rawURL string) bool {
+	parsed, err := url.Parse(rawURL)
+	return err == nil && parsed.Scheme == "https" && strings.EqualFold(parsed.Hostname(), gitHubHost)
+}
+
+// Normalize checks that the link points to a repository or to one of its tracked resources,
+// so malformed links are rejected when they are added instead of failing every check.
+func (p *GitHubProvider) Normalize(link *domain.Link) error {
+	if _, err := github.ParseLinkKind(link.URL); err != nil {
+		return fmt.Errorf("invalid GitHub link: %w", err)
+	}
+
+	return nil
+}
+
+func (p *GitHubProvider) ActivityKinds() []domain.ActivityKind {
+	return []domain.ActivityKind{
+		{Type: domain.GitHubRepository, Emoji: "📦", Noun: "repository change"},
+		{Type: domain.GitHubIssue, Emoji: "🐛", Noun: "issue"},
+		{Type: domain.GitHubPullRequest, Emoji: "🔀", Noun: "PR"},
+		{Type: domain.GitHubComment, Emoji: "💬", Noun: "comment"},
+		{Type: domain.GitHubReviewComment, Emoji: "💬", Noun: "review comment"},
+		{Type: domain.GitHubReview, Emoji: "👀", Noun: "review"},
+		{Type: domain.GitHubLabel, Emoji: "🏷", Noun: "label change"},
+		{Type: domain.GitHubState, Emoji: "🔄", Noun: "state change"},
+		{Type: domain.GitHubCheck, Emoji: "✅", Noun: "check"},
+		{Type: domain.GitHubRelease, Emoji: "🚀", Noun: "release"},
+		{Type: domain.GitHubTag, Emoji: "🔖", Noun: "tag"},
+		{Type: domain.GitHubCommit, Emoji: "📝", Noun: "commit"},
+	}
+}
+
+func (p *GitHubProvider) FetchActivity(ctx context.Context, links []*domain.Link) map[string]Result {
+	results := make(map[string]Result, len(links))
+
+	for _, link := range links {
+		if ctx.Err() != nil {
+			break
+		}
+
+		activities, metadata, err := p.getActivity(ctx, link)
+		results[link.URL] = Result{Activities: activities, Metadata: metadata, Err: gitHubError(err)}
+	}
+
+	return results
+}
+
+func (p *GitHubProvider) getActivity(ctx context.Context, link *domain.Link) ([]*domain.Activity, domain.LinkMetadata, error) {
+	p.logger.Info("Checking GitHub link for update", "url", link.URL)
+
+	if rateLimit := p.client.RateLimit(); rateLimit.Exhausted(time.Now()) {
+		return nil, link.Metadata, &github.RateLimitError{Reset: rateLimit.Reset}
+	}
+
+	linkKind, err := github.ParseLinkKind(link.URL)
+	if err != nil {
+		p.logger.Error("Invalid GitHub link", "url", link.URL, "error", err)
+		return nil, link.Metadata, fmt.Errorf("invalid GitHub link: %w", err)
+	}
+
+	validators := toGitHubValidators(link.Metadata.Validators)
+
+	var activity []*github.Activity
+
+	switch linkKind {
+	case github.LinkKindThread:
+		activity, err = p.client.GetThreadActivity(ctx, link.URL, link.LastCheck, validators)
+	case github.LinkKindReleases:
+		activity, err = p.client.GetReleaseActivity(ctx, link.URL, link.LastCheck, validators)
+	case github.LinkKindTags:
+		activity, err = p.client.GetTagActivity(ctx, link.URL, link.LastCheck, validators)
+	case github.LinkKindBranch:
+		activity, err = p.client.GetCommitActivity(ctx, link.URL, link.LastCheck, validators)
+	default:
+		activity, err = p.getRepoActivity(ctx, link, validators)
+	}
+
+	if err != nil {
+		p.logger.Error("Failed to get activity", "error", err)
+		return nil, link.Metadata, fmt.Errorf("failed to get activity: %w", err)
+	}
+
+	activities := make([]*domain.Activity, 0, len(activity))
+
+	for _, act := range activity {
+		var activityType domain.ActivityType
+
+		switch act.Type {
+		case github.ActivityTypeIssue:
+			activityType = domain.GitHubIssue
+		case github.ActivityTypePullRequest:
+			activityType = domain.GitHubPullRequest
+		case github.ActivityTypeRepository:
+			activityType = domain.GitHubRepository
+		case github.ActivityTypeComment:
+			activityType = domain.GitHubComment
+		case github.ActivityTypeReviewComment:
+			activityType = domain.GitHubReviewComment
+		case github.ActivityTypeReview:
+			activityType = domain.GitHubReview
+		case github.ActivityTypeLabel:
+			activityType = domain.GitHubLabel
+		case github.ActivityTypeState:
+			activityType = domain.GitHubState
+		case github.ActivityTypeCheck:
+			activityType = domain.GitHubCheck
+		case github.ActivityTypeRelease:
+			activityType = domain.GitHubRelease
+		case github.ActivityTypeTag:
+			activityType = domain.GitHubTag
+		case github.ActivityTypeCommit:
+			activityType = domain.GitHubCommit
+		default:
+			p.logger.Error("Unknown activity type", "type", act.Type)
+			return nil, link.Metadata, fmt.Errorf("unknown activity type: %s", act.Type)
+		}
+
+		activity := domain.NewActivity(
+			activityType,
+			act.ID,
+			act.Revision,
+			act.Title,
+			act.CreatedAt,
+			act.Body,
+			act.UserName,
+			act.Labels,
+		)
+		activity.URL = act.URL
+
+		activities = append(activities, activity)
+	}
+
+	metadata := link.Metadata
+	metadata.Validators = fromGitHubValidators(validators)
+
+	return activities, metadata, nil
+}
+
+func (p *GitHubProvider) getRepoActivity(
+	ctx context.Context,
+	link *domain.Link,
+	validators github.Validators,
+) ([]*github.Activity, error) {
+	repo, err := p.client.GetRepo(ctx, link.URL, validators)
+	if errors.Is(err, github.ErrNotModified) {
+		p.logger.Info("GitHub repository not modified", "url", link.URL)
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	if !repo.UpdatedAt.After(link.LastCheck) {
+		return nil, nil
+	}
+
+	return p.client.GetActivity(ctx, repo, link.LastCheck, validators)
+}
+
+// gitHubError converts the errors of the GitHub client that the scrapper handles to the errors of the provider.
+func gitHubError(err error) error {
+	var rateLimitErr *github.RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return &RateLimitError{Reset: rateLimitErr.Reset, Err: err}
+	}
+
+	if errors.Is(err, github.ErrNotFound) {
+		return fmt.Errorf("%w: %w", ErrGone, err)
+	}
+
+	return err
+}
+
+func toGitHubValidators(validators map[string]domain.Validator) github.Validators {
+	result := make(github.Validators, len(validators))
+
+	for key, validator := range validators {
+		result[key] = github.Validator{ETag: validator.ETag, LastModified: validator.LastModified}
+	}
+
+	return result
+}
+
+func fromGitHubValidators(validators github.Validators) map[string]domain.Validator {
+	result := make(map[string]domain.Validator, len(validators))
+
+	for key, validator := range validators {
+		result[key] = domain.Validator{ETag: validator.ETag, LastModified: validator.LastModified}
+	}
+
+	return result
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AFK068/bot/internal/domain"
+)
+
+// ErrGone is wrapped by the errors of links that no longer exist at their source, such as deleted issues.
+var ErrGone = errors.New("link no longer exists")
+
+// RateLimitError is returned for links that were not checked because their source
+// rejects requests until Reset. Err is the error of the provider client.
+type RateLimitError struct {
+	Reset time.Time
+	Err   error
+}
+
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// Provider is a source of tracked links. It recognizes the links of its source
+// and fetches their activity for the scrapper.
+type Provider interface {
+	// Type is the link type stored for the links of the provider.
+	Type() string
+	// Match reports whether the URL belongs to the provider.
+	Match(url string) bool
+	// Normalize validates the link and fills its provider specific fields.
+	Normalize(link *domain.Link) error
+	// FetchActivity fetches the activity of the links since their last check, keyed by the link URL.
+	// All links are of the provider type, so they can be fetched in batches.
+	FetchActivity(ctx context.Context, links []*domain.Link) map[string]Result
+	// ActivityKinds lists the activity types reported by the provider and how their updates are shown.
+	ActivityKinds() []domain.ActivityKind
+}
+
+// Result is the fetched activity of a link or the error that prevented fetching it, which wraps
+// ErrGone or is a RateLimitError when the provider knows why. Metadata is the state of the link
+// to store once the activity is delivered.
+type Result struct {
+	Activities []*domain.Activity
+	Metadata   domain.LinkMetadata
+	Err        error
+}
+
+// Registry holds the providers of every supported link source.
+type Registry struct {
+	providers []Provider
+	byType    map[string]Provider
+	kinds     map[domain.ActivityType]domain.ActivityKind
+}
+
+func NewRegistry(providers ...Provider) (*Registry, error) {
+	registry := &Registry{
+		providers: providers,
+		byType:    make(map[string]Provider, len(providers)),
+		kinds:     make(map[domain.ActivityType]domain.ActivityKind),
+	}
+
+	for _, provider := range providers {
+		if _, ok := registry.byType[provider.Type()]; ok {
+			return nil, fmt.Errorf("provider for link type %q is registered twice", provider.Type())
+		}
+
+		registry.byType[provider.Type()] = provider
+
+		for _, kind := range provider.ActivityKinds() {
+			registry.kinds[kind.Type] = kind
+		}
+	}
+
+	return registry, nil
+}
+
+// Match returns the first registered provider the URL belongs to.
+func (r *Registry) Match(url string) (Provider, bool) {
+	for _, provider := range r.providers {
+		if provider.Match(url) {
+			return provider, true
+		}
+	}
+
+	return nil, false
+}
+
+// Get returns the provider of the link type.
+func (r *Registry) Get(linkType string) (Provider, bool) {
+	provider, ok := r.byType[linkType]
+	return provider, ok
+}
+
+// ActivityTypes returns the activity types reported by all providers.
+func (r *Registry) ActivityTypes() []domain.ActivityType {
+	var activityTypes []domain.ActivityType
+
+	for _, provider := range r.providers {
+		for _, kind := range provider.ActivityKinds() {
+			activityTypes = append(activityTypes, kind.Type)
+		}
+	}
+
+	return activityTypes
+}
+
+// ActivityKind returns the kind of the activity type reported by one of the providers.
+func (r *Registry) ActivityKind(activityType domain.ActivityType) (domain.ActivityKind, bool) {
+	kind, ok := r.kinds[activityType]
+	return kind, ok
+}
@@ -0,0 +1,141 @@
+package provider_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/application/provider"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/pkg/client/github"
+	"github.com/AFK068/bot/pkg/client/stackoverflow"
+
+	providerMock "github.com/AFK068/bot/internal/application/provider/mocks"
+)
+
+func newRegistry(t *testing.T) *provider.Registry {
+	registry, err := provider.NewRegistry(
+		provider.NewGitHubProvider(nil, logger.NewDiscardLogger()),
+		provider.NewStackOverflowProvider(nil, logger.NewDiscardLogger()),
+	)
+	require.NoError(t, err)
+
+	return registry
+}
+
+func Test_Registry_Match(t *testing.T) {
+	registry := newRegistry(t)
+
+	tests := []struct {
+		name     string
+		url      string
+		wantType string
+		wantOK   bool
+	}{
+		{name: "GitHub", url: "https://github.com/test/test", wantType: domain.GithubType, wantOK: true},
+		{name: "Stack Overflow", url: "https://stackoverflow.com/questions/1", wantType: domain.StackoverflowType, wantOK: true},
+		{name: "Stack Exchange", url: "https://math.stackexchange.com/questions/1", wantType: domain.StackoverflowType, wantOK: true},
+		{name: "Unsupported", url: "https://example.com/test", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			linkProvider, ok := registry.Match(tt.url)
+
+			require.Equal(t, tt.wantOK, ok)
+
+			if ok {
+				assert.Equal(t, tt.wantType, linkProvider.Type())
+			}
+		})
+	}
+}
+
+func Test_Registry_Get(t *testing.T) {
+	registry := newRegistry(t)
+
+	linkProvider, ok := registry.Get(domain.StackoverflowType)
+	require.True(t, ok)
+	assert.Equal(t, domain.StackoverflowType, linkProvider.Type())
+
+	_, ok = registry.Get("gitlab")
+	assert.False(t, ok)
+}
+
+func Test_Registry_ActivityTypes(t *testing.T) {
+	activityTypes := newRegistry(t).ActivityTypes()
+
+	assert.Contains(t, activityTypes, domain.GitHubPullRequest)
+	assert.Contains(t, activityTypes, domain.StackoverflowAnswer)
+	assert.NotContains(t, activityTypes, domain.LinkBroken)
+}
+
+func Test_NewRegistry_DuplicateType(t *testing.T) {
+	duplicate := providerMock.NewProvider(t)
+	duplicate.On("Type").Return(domain.GithubType)
+
+	_, err := provider.NewRegistry(provider.NewGitHubProvider(nil, logger.NewDiscardLogger()), duplicate)
+	assert.Error(t, err)
+}
+
+func Test_StackOverflowProvider_Normalize(t *testing.T) {
+	link := &domain.Link{URL: "https://www.superuser.com/questions/1"}
+
+	err := provider.NewStackOverflowProvider(nil, logger.NewDiscardLogger()).Normalize(link)
+	require.NoError(t, err)
+
+	assert.Equal(t, "superuser.com", link.Metadata.Site)
+}
+
+func Test_GitHubProvider_FetchActivity_Errors(t *testing.T) {
+	client := providerMock.NewGitHubRepoFetcher(t)
+	gitHubProvider := provider.NewGitHubProvider(client, logger.NewDiscardLogger())
+
+	reset := time.Now().Add(time.Hour)
+
+	client.On("RateLimit").Return(github.RateLimit{Remaining: 0, Reset: reset}).Once()
+
+	link := &domain.Link{URL: "https://github.com/test/test/issues/1"}
+
+	result := gitHubProvider.FetchActivity(context.Background(), []*domain.Link{link})[link.URL]
+
+	var rateLimitErr *provider.RateLimitError
+	require.ErrorAs(t, result.Err, &rateLimitErr)
+	assert.Equal(t, reset, rateLimitErr.Reset)
+
+	client.On("RateLimit").Return(github.RateLimit{}).Once()
+	client.On("GetThreadActivity", mock.Anything, link.URL, link.LastCheck, mock.Anything).Return(nil, github.ErrNotFound).Once()
+
+	result = gitHubProvider.FetchActivity(context.Background(), []*domain.Link{link})[link.URL]
+
+	assert.ErrorIs(t, result.Err, provider.ErrGone)
+}
+
+func Test_StackOverflowProvider_FetchActivity_Errors(t *testing.T) {
+	client := providerMock.NewStackOverlowQuestionFetcher(t)
+	stackOverflowProvider := provider.NewStackOverflowProvider(client, logger.NewDiscardLogger())
+
+	reset := time.Now().Add(time.Hour)
+
+	client.On("Quota").Return(stackoverflow.Quota{Remaining: 0, Reset: reset}).Once()
+
+	link := &domain.Link{URL: "https://stackoverflow.com/questions/1", Metadata: domain.LinkMetadata{Site: "stackoverflow"}}
+
+	result := stackOverflowProvider.FetchActivity(context.Background(), []*domain.Link{link})[link.URL]
+
+	var rateLimitErr *provider.RateLimitError
+	require.ErrorAs(t, result.Err, &rateLimitErr)
+	assert.Equal(t, reset, rateLimitErr.Reset)
+
+	client.On("Quota").Return(stackoverflow.Quota{}).Once()
+	client.On("GetQuestions", mock.Anything, "stackoverflow", []int64{1}).Return([]*stackoverflow.Question{}, nil).Once()
+
+	result = stackOverflowProvider.FetchActivity(context.Background(), []*domain.Link{link})[link.URL]
+
+	assert.ErrorIs(t, result.Err, provider.ErrGone)
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/pkg/client/stackoverflow"
+)
+
+type StackOverlowQuestionFetcher interface {
+	GetQuestions(ctx context.Context, site string, ids []int64) ([]*stackoverflow.Question, error)
+	GetQuestionsActivity(
+		ctx context.Context,
+		site string,
+		questions []*stackoverflow.Question,
+		since time.Time,
+	) (map[int64][]*stackoverflow.Activity, error)
+	Quota() stackoverflow.Quota
+}
+
+// StackOverflowProvider tracks questions on Stack Overflow and the other Stack Exchange sites.
+// Links are grouped per site, so each site costs a few batched requests instead of
+// several requests per link.
+type StackOverflowProvider struct {
+	client StackOverlowQuestionFetcher
+	logger *logger.Logger
+}
+
+func NewStackOverflowProvider(client StackOverlowQuestionFetcher, log *logger.Logger) *StackOverflowProvider {
+	return &StackOverflowProvider{
+		client: client,
+		logger: log,
+	}
+}
+
+func (p *StackOverflowProvider) Type() string {
+	return domain.StackoverflowType
+}
+
+func (p *StackOverflowProvider) Match(url string) bool {
+	_, err := stackoverflow.ParseSite(url)
+	return err == nil
+}
+
+// Normalize stores the Stack Exchange API site of the link.
+func (p *StackOverflowProvider) Normalize(link *domain.Link) error {
+	site, err := stackoverflow.ParseSite(link.URL)
+	if err != nil {
+		return fmt.Errorf("invalid Stack Exchange link: %w", err)
+	}
+
+	link.Metadata.Site = site
+
+	return nil
+}
+
+func (p *StackOverflowProvider) ActivityKinds() []domain.ActivityKind {
+	return []domain.ActivityKind{
+		{Type: domain.StackoverflowComment, Emoji: "💬", Noun: "comment"},
+		{Type: domain.StackoverflowAnswer, Emoji: "💡", Noun: "answer"},
+		{Type: domain.StackoverflowQuestion, Emoji: "❓", Noun: "question edit"},
+	}
+}
+
+func (p *StackOverflowProvider) FetchActivity(ctx context.Context, links []*domain.Link) map[string]Result {
+	results := make(map[string]Result)
+	bySite := make(map[string][]*domain.Link)
+
+	for _, link := range links {
+		site := link.Metadata.Site
+		if site == "" {
+			parsed, err := stackoverflow.ParseSite(link.URL)
+			if err != nil {
+				results[link.URL] = Result{Err: fmt.Errorf("invalid Stack Exchange link: %w", err)}
+				continue
+			}
+
+			site = parsed
+		}
+
+		bySite[site] = append(bySite[site], link)
+	}
+
+	for site, siteLinks := range bySite {
+		p.fetchSiteActivity(ctx, site, siteLinks, results)
+	}
+
+	for linkURL, result := range results {
+		result.Err = stackExchangeError(result.Err)
+		results[linkURL] = result
+	}
+
+	return results
+}
+
+// stackExchangeError converts the errors of the Stack Exchange client that the scrapper handles to the errors of the provider.
+func stackExchangeError(err error) error {
+	var quotaErr *stackoverflow.QuotaError
+	if errors.As(err, &quotaErr) {
+		return &RateLimitError{Reset: quotaErr.Reset, Err: err}
+	}
+
+	if errors.Is(err, stackoverflow.ErrQuestionNotFound) {
+		return fmt.Errorf("%w: %w", ErrGone, err)
+	}
+
+	return err
+}
+
+func (p *StackOverflowProvider) fetchSiteActivity(
+	ctx context.Context,
+	site string,
+	links []*domain.Link,
+	results map[string]Result,
+) {
+	p.logger.Info("Checking StackOverflow links for update", "site", site, "count", len(links))
+
+	fail := func(err error) {
+		for _, link := range links {
+			if _, ok := results[link.URL]; !ok {
+				results[link.URL] = Result{Err: err}
+			}
+		}
+	}
+
+	if quota := p.client.Quota(); quota.Exhausted(time.Now()) {
+		fail(&stackoverflow.QuotaError{Reset: quota.Reset})
+		return
+	}
+
+	linksByID := make(map[int64][]*domain.Link)
+	ids := make([]int64, 0, len(links))
+
+	for _, link := range links {
+		id, err := stackoverflow.ParseQuestionID(link.URL)
+		if err != nil {
+			results[link.URL] = Result{Err: fmt.Errorf("invalid question link: %w", err)}
+			continue
+		}
+
+		if _, ok := linksByID[id]; !ok {
+			ids = append(ids, id)
+		}
+
+		linksByID[id] = append(linksByID[id], link)
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	questions, err := p.client.GetQuestions(ctx, site, ids)
+	if err != nil {
+		p.logger.Error("Failed to get questions", "site", site, "error", err)
+		fail(fmt.Errorf("failed to get questions: %w", err))
+
+		return
+	}
+
+	// Only questions active since the last check of one of their links are expanded,
+	// starting from the oldest of those checks.
+	var (
+		active []*stackoverflow.Question
+		since  time.Time
+		found  = make(map[int64]bool, len(questions))
+	)
+
+	for _, question := range questions {
+		found[question.ID] = true
+		isActive := false
+
+		for _, link := range linksByID[question.ID] {
+			if question.LastActivityDate <= link.LastCheck.Unix() {
+				continue
+			}
+
+			isActive = true
+
+			if since.IsZero() || link.LastCheck.Before(since) {
+				since = link.LastCheck
+			}
+		}
+
+		if isActive {
+			active = append(active, question)
+		}
+	}
+
+	var activity map[int64][]*stackoverflow.Activity
+
+	if len(active) != 0 {
+		activity, err = p.client.GetQuestionsActivity(ctx, site, active, since)
+		if err != nil {
+			p.logger.Error("Failed to get activity", "site", site, "error", err)
+			fail(fmt.Errorf("failed to get activity: %w", err))
+
+			return
+		}
+	}
+
+	for id, idLinks := range linksByID {
+		for _, link := range idLinks {
+			if !found[id] {
+				results[link.URL] = Result{Err: fmt.Errorf("failed to get question: %w", stackoverflow.ErrQuestionNotFound)}
+				continue
+			}
+
+			activities, err := p.mapActivity(activity[id], link.LastCheck)
+			results[link.URL] = Result{Activities: activities, Metadata: link.Metadata, Err: err}
+		}
+	}
+}
+
+// mapActivity converts the activity newer than the last check of the link.
+func (p *StackOverflowProvider) mapActivity(activity []*stackoverflow.Activity, lastCheck time.Time) ([]*domain.Activity, error) {
+	var activities []*domain.Activity
+
+	for _, act := range activity {
+		if act.CreatedAt <= lastCheck.Unix() {
+			continue
+		}
+
+		var activityType domain.ActivityType
+
+		switch act.Type {
+		case stackoverflow.ActivityTypeAnswer:
+			activityType = domain.StackoverflowAnswer
+		case stackoverflow.ActivityTypeQuestion:
+			activityType = domain.StackoverflowQuestion
+		case stackoverflow.ActivityTypeComment:
+			activityType = domain.StackoverflowComment
+		default:
+			p.logger.Error("Unknown activity type", "type", act.Type)
+			return nil, fmt.Errorf("unknown activity type: %s", act.Type)
+		}
+
+		activity := domain.NewActivity(
+			activityType,
+			act.ID,
+			act.Revision,
+			"",
+			time.Unix(act.CreatedAt, 0),
+			act.Body,
+			act.UserName,
+			act.Tags,
+		)
+		activity.URL = act.URL
+
+		activities = append(activities, activity)
+	}
+
+	return activities, nil
+}
@@ -1,19 +1,79 @@
 package scrapper
 
-import "github.com/ilyakaznacheev/cleanenv"
+import (
+	"fmt"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/domain"
+)
 
 type Config struct {
 	Host   string `yaml:"host" env:"SCRAPPER_HOST" env-required:"true"`
 	Port   string `yaml:"port" env:"SCRAPPER_PORT" env-required:"true"`
 	BotURL string `yaml:"bot_url" env:"SCRAPPER_BOT_URL" env-required:"true"`
+
+	GRPCPort    string `yaml:"grpc_port" env:"SCRAPPER_GRPC_PORT" env-default:"9081"`
+	BotGRPCAddr string `yaml:"bot_grpc_addr" env:"SCRAPPER_BOT_GRPC_ADDR" env-default:"bot:9080"`
+
+	// GitHubToken is a personal access token or a GitHub App installation token.
+	GitHubToken string `yaml:"github_token" env:"GITHUB_TOKEN"`
+
+	// StackExchangeKey is the app key raising the daily Stack Exchange API quota.
+	StackExchangeKey string `yaml:"stackexchange_key" env:"STACKEXCHANGE_KEY"`
+
+	// AdminToken guards the admin endpoints, which are disabled when it is empty.
+	AdminToken string `yaml:"admin_token" env:"SCRAPPER_ADMIN_TOKEN"`
+
+	// MinCheckInterval and MaxCheckInterval bound the adaptive check interval of a link.
+	// A link is checked at the minimum interval after activity and backs off to the
+	// maximum while nothing changes.
+	MinCheckInterval time.Duration `yaml:"min_check_interval" env:"SCRAPPER_MIN_CHECK_INTERVAL" env-default:"15s"`
+	MaxCheckInterval time.Duration `yaml:"max_check_interval" env:"SCRAPPER_MAX_CHECK_INTERVAL" env-default:"1h"`
+
+	// MaxCheckFailures is the number of failed checks in a row after which a link is marked broken.
+	MaxCheckFailures int `yaml:"max_check_failures" env:"SCRAPPER_MAX_CHECK_FAILURES" env-default:"5"`
+
+	// BurstThreshold is the number of updates of one link per check above which a chat
+	// gets them collapsed into one summary message.
+	BurstThreshold int `yaml:"burst_threshold" env:"SCRAPPER_BURST_THRESHOLD" env-default:"10"`
+
+	// Transport selects how link updates are delivered to the bot.
+	Transport domain.TransportType `yaml:"transport" env:"TRANSPORT" env-default:"http"`
+	Kafka     config.Kafka         `yaml:"kafka"`
 }
 
 func NewConfig(file string) (*Config, error) {
-	config := &Config{}
+	cfg := &Config{}
 
-	if err := cleanenv.ReadConfig(file, config); err != nil {
+	if err := cleanenv.ReadConfig(file, cfg); err != nil {
 		return nil, err
 	}
 
-	return config, nil
+	transport, err := domain.ParseTransport(cfg.Transport, domain.HTTPTransport, domain.KafkaTransport, domain.GRPCTransport)
+	if err != nil {
+		return nil, fmt.Errorf("transport: %w", err)
+	}
+
+	cfg.Transport = transport
+
+	if cfg.MinCheckInterval <= 0 {
+		cfg.MinCheckInterval = DefaultMinCheckInterval
+	}
+
+	if cfg.MaxCheckInterval < cfg.MinCheckInterval {
+		cfg.MaxCheckInterval = cfg.MinCheckInterval
+	}
+
+	if cfg.MaxCheckFailures <= 0 {
+		cfg.MaxCheckFailures = DefaultMaxCheckFailures
+	}
+
+	if cfg.BurstThreshold <= 0 {
+		cfg.BurstThreshold = DefaultBurstThreshold
+	}
+
+	return cfg, nil
 }
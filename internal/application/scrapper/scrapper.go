@@ -2,54 +2,68 @@ package scrapper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
 	"runtime"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/go-co-op/gocron/v2"
 
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/domain"
-	"github.com/AFK068/bot/internal/infrastructure/clients/bot"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
-	"github.com/AFK068/bot/pkg/client/github"
-	"github.com/AFK068/bot/pkg/client/stackoverflow"
 	"github.com/AFK068/bot/pkg/utils"
 
 	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
 )
 
 const (
-	DefaultJobDuration = 15 * time.Second
+	// DefaultJobDuration is how often the scrapper looks for links due for a check.
+	DefaultJobDuration = 5 * time.Second
+
+	DefaultMinCheckInterval = 15 * time.Second
+	DefaultMaxCheckInterval = time.Hour
+	DefaultMaxCheckFailures = 5
+	DefaultBurstThreshold   = 10
 
 	PaginationLimit uint64 = 50
-)
 
-type StackOverlowQuestionFetcher interface {
-	GetQuestion(ctx context.Context, questionURL string) (*stackoverflow.Question, error)
-	GetActivity(ctx context.Context, question *stackoverflow.Question, lastCheckTime time.Time) ([]*stackoverflow.Activity, error)
-}
+	// DefaultClaimLease is how long a claimed link is hidden from other instances.
+	// Links claimed by a crashed instance are picked up again after it expires.
+	DefaultClaimLease = time.Minute
+)
 
-type GitHubRepoFetcher interface {
-	GetRepo(ctx context.Context, questionURL string) (*github.Repository, error)
-	GetActivity(ctx context.Context, repository *github.Repository, lastCheckTime time.Time) ([]*github.Activity, error)
+type Transactor interface {
+	WithTransaction(ctx context.Context, txFunc func(ctx context.Context) error) error
 }
 
 type Scrapper struct {
-	scheduler           gocron.Scheduler
-	repository          domain.ChatLinkRepository
-	stackOverflowClient StackOverlowQuestionFetcher
-	gitHubClient        GitHubRepoFetcher
-	botClient           bot.Service
-	logger              *logger.Logger
+	scheduler        gocron.Scheduler
+	repository       domain.ChatLinkRepository
+	activities       domain.ActivityRepository
+	digests          domain.DigestRepository
+	outbox           domain.OutboxRepository
+	transactor       Transactor
+	providers        *provider.Registry
+	logger           *logger.Logger
+	minCheckInterval time.Duration
+	maxCheckInterval time.Duration
+	maxCheckFailures int
+	burstThreshold   int
 }
 
 func NewScrapperScheduler(
+	cfg *Config,
 	repository domain.ChatLinkRepository,
-	stackoverflowClient StackOverlowQuestionFetcher,
-	githubClient GitHubRepoFetcher,
-	botClient bot.Service,
+	activities domain.ActivityRepository,
+	digests domain.DigestRepository,
+	outbox domain.OutboxRepository,
+	transactor Transactor,
+	providers *provider.Registry,
 	log *logger.Logger,
 ) (*Scrapper, error) {
 	scheduler, err := gocron.NewScheduler()
@@ -58,12 +72,18 @@ func NewScrapperScheduler(
 	}
 
 	return &Scrapper{
-		scheduler:           scheduler,
-		repository:          repository,
-		stackOverflowClient: stackoverflowClient,
-		gitHubClient:        githubClient,
-		botClient:           botClient,
-		logger:              log,
+		scheduler:        scheduler,
+		repository:       repository,
+		activities:       activities,
+		digests:          digests,
+		outbox:           outbox,
+		transactor:       transactor,
+		providers:        providers,
+		logger:           log,
+		minCheckInterval: cfg.MinCheckInterval,
+		maxCheckInterval: cfg.MaxCheckInterval,
+		maxCheckFailures: cfg.MaxCheckFailures,
+		burstThreshold:   cfg.BurstThreshold,
 	}, nil
 }
 
@@ -99,154 +119,344 @@ func (s *Scrapper) Stop() error {
 	return nil
 }
 
-func (s *Scrapper) notifyBot(ctx context.Context, activities []*domain.Activity, link *domain.Link) error {
-	s.logger.Info("Notifying bot for link", "url", link.URL)
+// enqueueUpdates stores an update per activity in the outbox for the subscribers
+// that have not seen it yet and have not muted the link, and buffers it for the
+// subscribers in digest mode or in their quiet hours. Chats getting more updates
+// of the link than the burst threshold get them as one batch instead. It runs in
+// the transaction that moves the last check of the link, so updates are neither
+// lost nor duplicated if the tick fails.
+func (s *Scrapper) enqueueUpdates(ctx context.Context, activities []*domain.Activity, link *domain.Link) error {
+	s.logger.Info("Enqueueing updates for link", "url", link.URL)
 
-	chatIDs, err := s.repository.GetChatIDsByLink(ctx, link)
+	subscribers, err := s.repository.GetSubscribersByLink(ctx, link)
 	if err != nil {
-		s.logger.Error("Error getting chat IDs", "error", err)
-		return fmt.Errorf("error getting chat IDs: %w", err)
+		s.logger.Error("Error getting subscribers", "error", err)
+		return fmt.Errorf("error getting subscribers: %w", err)
 	}
 
-	if len(chatIDs) == 0 {
+	if len(subscribers) == 0 {
 		s.logger.Warn("No chat IDs found for link", "url", link.URL)
 		return nil
 	}
 
-	for _, activity := range activities {
-		activityType := activity.MapActivityTypeToBotAPI()
-		if activityType == nil {
-			s.logger.Error("Activity type is nil", "activity", activity)
-			return fmt.Errorf("invalid activity type: %v", activity.Type)
-		}
+	filters := s.parseSubscriberFilters(subscribers)
+
+	chatsSettings, err := s.getChatsSettings(ctx, subscribers)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	// received holds the indexes of the activities every chat gets right away.
+	received := make(map[int64][]int)
+
+	for i, activity := range activities {
+		for _, subscriber := range subscribers {
+			// The link is scraped from its own last check time, so skip subscribers
+			// that have already seen the activity or added the link after it.
+			if !subscriber.LastCheck.Before(activity.CreatedAt) || !filters[subscriber.UserAddID].Match(activity) {
+				continue
+			}
 
-		userName := "Unknown"
-		if activity.UserName != "" {
-			userName = activity.UserName
+			// Updates of a muted link are dropped, not held until the link is unmuted.
+			if subscriber.MutedUntil.After(now) {
+				continue
+			}
+
+			settings := chatsSettings[subscriber.UserAddID]
+
+			held, err := s.holdUpdate(ctx, settings, subscriber, s.newDigestEntry(link, subscriber.Tags, activity), now)
+			if err != nil {
+				return err
+			}
+
+			if !held {
+				received[subscriber.UserAddID] = append(received[subscriber.UserAddID], i)
+			}
 		}
+	}
+
+	if err := s.enqueueActivities(ctx, link, activities, received, chatsSettings); err != nil {
+		return err
+	}
+
+	return s.enqueueBursts(ctx, link, activities, received, chatsSettings)
+}
 
-		description := "No description"
-		if activity.Body != "" {
-			description = activity.Body
+// enqueueActivities stores an update per activity for the chats below the burst threshold.
+// Chats are grouped by timezone, so the bot shows the times in the zone of every chat.
+func (s *Scrapper) enqueueActivities(
+	ctx context.Context,
+	link *domain.Link,
+	activities []*domain.Activity,
+	received map[int64][]int,
+	chatsSettings map[int64]*domain.ChatSettings,
+) error {
+	recipients := make([]map[string][]int64, len(activities))
+
+	for _, chatID := range slices.Sorted(maps.Keys(received)) {
+		if len(received[chatID]) > s.burstThreshold {
+			continue
 		}
 
-		update := bottypes.LinkUpdate{
-			TgChatIds:   utils.SliceInt64Ptr(chatIDs),
-			СreatedAt:   &activity.CreatedAt,
-			Type:        activityType,
-			Url:         aws.String(link.URL),
-			UserName:    aws.String(userName),
-			Description: aws.String(description),
+		timezone := chatsSettings[chatID].Timezone
+
+		for _, i := range received[chatID] {
+			if recipients[i] == nil {
+				recipients[i] = make(map[string][]int64)
+			}
+
+			recipients[i][timezone] = append(recipients[i][timezone], chatID)
 		}
+	}
 
-		if err := s.botClient.PostUpdates(ctx, update); err != nil {
-			s.logger.Error("Error posting update to bot", "error", err)
-			return fmt.Errorf("error posting update to bot: %w", err)
+	for i, activity := range activities {
+		if len(recipients[i]) == 0 {
+			s.logger.Info("Activity has no new subscribers to notify immediately", "url", link.URL, "type", activity.Type)
+			continue
 		}
 
-		s.logger.Info(*update.Description)
+		for _, timezone := range slices.Sorted(maps.Keys(recipients[i])) {
+			update := s.newLinkUpdate(link, activity, recipients[i][timezone], timezone)
+
+			if err := s.outbox.AddOutboxMessage(ctx, update); err != nil {
+				s.logger.Error("Error adding update to outbox", "error", err)
+				return fmt.Errorf("error adding update to outbox: %w", err)
+			}
+
+			s.logger.Info(*update.Description)
+		}
 	}
 
 	return nil
 }
 
-func (s *Scrapper) getActivity(ctx context.Context, link *domain.Link) ([]*domain.Activity, error) {
-	s.logger.Info("Checking link for update", "url", link.URL)
+// enqueueBursts stores one batch update for the chats above the burst threshold. Chats
+// getting the same activities in the same timezone share the batch.
+func (s *Scrapper) enqueueBursts(
+	ctx context.Context,
+	link *domain.Link,
+	activities []*domain.Activity,
+	received map[int64][]int,
+	chatsSettings map[int64]*domain.ChatSettings,
+) error {
+	type burst struct {
+		timezone string
+		indexes  []int
+		chatIDs  []int64
+	}
+
+	bursts := make(map[string]*burst)
+
+	for _, chatID := range slices.Sorted(maps.Keys(received)) {
+		if len(received[chatID]) <= s.burstThreshold {
+			continue
+		}
+
+		timezone := chatsSettings[chatID].Timezone
+		key := fmt.Sprint(timezone, received[chatID])
 
-	switch link.Type {
-	case domain.StackoverflowType:
-		return s.getStackOverflowActivity(ctx, link)
-	case domain.GithubType:
-		return s.getGitHubActivity(ctx, link)
-	default:
-		s.logger.Error("Unknown link type", "type", link.Type)
-		return nil, fmt.Errorf("unknown link type: %s", link.Type)
+		if bursts[key] == nil {
+			bursts[key] = &burst{timezone: timezone, indexes: received[chatID]}
+		}
+
+		bursts[key].chatIDs = append(bursts[key].chatIDs, chatID)
 	}
+
+	for _, key := range slices.Sorted(maps.Keys(bursts)) {
+		burst := bursts[key]
+
+		entries := make([]bottypes.DigestEntry, len(burst.indexes))
+		for i, index := range burst.indexes {
+			entries[i] = s.newDigestEntry(link, nil, activities[index])
+		}
+
+		update := bottypes.LinkUpdate{
+			TgChatIds: utils.SliceInt64Ptr(burst.chatIDs),
+			Type:      aws.String(string(domain.LinkBatch)),
+			Url:       aws.String(link.URL),
+			Timezone:  aws.String(burst.timezone),
+			Batch:     &entries,
+		}
+
+		if err := s.outbox.AddOutboxMessage(ctx, update); err != nil {
+			s.logger.Error("Error adding batch to outbox", "error", err)
+			return fmt.Errorf("error adding batch to outbox: %w", err)
+		}
+
+		s.logger.Info("Collapsed burst of updates", "url", link.URL, "updates", len(entries), "chats", len(burst.chatIDs))
+	}
+
+	return nil
 }
 
-func (s *Scrapper) getStackOverflowActivity(ctx context.Context, link *domain.Link) ([]*domain.Activity, error) {
-	s.logger.Info("Checking StackOverflow link for update", "url", link.URL)
+// getChatsSettings returns the delivery settings of the subscribers by chat.
+func (s *Scrapper) getChatsSettings(ctx context.Context, subscribers []*domain.Link) (map[int64]*domain.ChatSettings, error) {
+	chatIDs := make([]int64, len(subscribers))
+	for i, subscriber := range subscribers {
+		chatIDs[i] = subscriber.UserAddID
+	}
 
-	question, err := s.stackOverflowClient.GetQuestion(ctx, link.URL)
+	chatsSettings, err := s.digests.GetChatsSettings(ctx, chatIDs)
 	if err != nil {
-		s.logger.Error("Failed to get question", "error", err)
-		return nil, fmt.Errorf("failed to get question: %w", err)
+		s.logger.Error("Error getting chats settings", "error", err)
+		return nil, fmt.Errorf("error getting chats settings: %w", err)
 	}
 
-	var activities []*domain.Activity
+	if chatsSettings == nil {
+		chatsSettings = make(map[int64]*domain.ChatSettings, len(chatIDs))
+	}
 
-	if question.LastActivityDate > link.LastCheck.Unix() {
-		activity, err := s.stackOverflowClient.GetActivity(ctx, question, link.LastCheck)
-		if err != nil {
-			s.logger.Error("Failed to get activity", "error", err)
-			return nil, fmt.Errorf("failed to get activity: %w", err)
+	for _, chatID := range chatIDs {
+		if chatsSettings[chatID] == nil {
+			chatsSettings[chatID] = domain.DefaultChatSettings(chatID)
 		}
+	}
 
-		for _, act := range activity {
-			var activityType domain.ActivityType
-
-			switch act.Type {
-			case stackoverflow.ActivityTypeAnswer:
-				activityType = domain.StackoverflowAnswer
-			case stackoverflow.ActivityTypeQuestion:
-				activityType = domain.StackoverflowQuestion
-			case stackoverflow.ActivityTypeComment:
-				activityType = domain.StackoverflowComment
-			default:
-				s.logger.Error("Unknown activity type", "type", act.Type)
-				return nil, fmt.Errorf("unknown activity type: %s", act.Type)
-			}
+	return chatsSettings, nil
+}
 
-			activities = append(activities, domain.NewActivity(activityType, "", time.Unix(act.CreatedAt, 0), act.Body, act.UserName))
-		}
+// holdUpdate buffers the update for the digest of a subscriber in digest mode, or until the end
+// of the quiet hours of the subscriber unless the link is urgent for it. It reports whether the
+// update was held. The updates held for a chat in the immediate mode are sent as a digest at the
+// end of the quiet hours.
+func (s *Scrapper) holdUpdate(
+	ctx context.Context,
+	settings *domain.ChatSettings,
+	subscriber *domain.Link,
+	entry bottypes.DigestEntry,
+	now time.Time,
+) (bool, error) {
+	var quietUntil time.Time
+	if !subscriber.Urgent {
+		quietUntil = settings.QuietUntil(now)
+	}
+
+	if !settings.Digest() && quietUntil.IsZero() {
+		return false, nil
+	}
+
+	if err := s.digests.AddDigestEntry(ctx, subscriber.UserAddID, entry); err != nil {
+		s.logger.Error("Error adding update to digest", "error", err)
+		return false, fmt.Errorf("error adding update to digest: %w", err)
+	}
+
+	if settings.Digest() || (!settings.NextDigestAt.IsZero() && !settings.NextDigestAt.After(quietUntil)) {
+		return true, nil
 	}
 
-	return activities, nil
+	settings.NextDigestAt = quietUntil
+
+	if err := s.digests.UpdateNextDigest(ctx, settings); err != nil {
+		s.logger.Error("Error scheduling held updates", "error", err)
+		return false, fmt.Errorf("error scheduling held updates: %w", err)
+	}
+
+	return true, nil
 }
 
-func (s *Scrapper) getGitHubActivity(ctx context.Context, link *domain.Link) ([]*domain.Activity, error) {
-	s.logger.Info("Checking GitHub link for update", "url", link.URL)
+// newLinkUpdate returns the update of the activity, shown with the emoji and the noun its provider gives its type.
+func (s *Scrapper) newLinkUpdate(link *domain.Link, activity *domain.Activity, chatIDs []int64, timezone string) bottypes.LinkUpdate {
+	kind, _ := s.providers.ActivityKind(activity.Type)
 
-	repo, err := s.gitHubClient.GetRepo(ctx, link.URL)
-	if err != nil {
-		s.logger.Error("Failed to get repository", "error", err)
-		return nil, fmt.Errorf("failed to get repository: %w", err)
+	userName := "Unknown"
+	if activity.UserName != "" {
+		userName = activity.UserName
+	}
+
+	description := "No description"
+	if activity.Body != "" {
+		description = activity.Body
+	}
+
+	activityURL := link.URL
+	if activity.URL != "" {
+		activityURL = activity.URL
+	}
+
+	return bottypes.LinkUpdate{
+		Id:          aws.Int64(link.ID),
+		TgChatIds:   utils.SliceInt64Ptr(chatIDs),
+		СreatedAt:   &activity.CreatedAt,
+		Type:        aws.String(string(activity.Type)),
+		Url:         aws.String(link.URL),
+		UserName:    aws.String(userName),
+		Description: aws.String(description),
+		Timezone:    aws.String(timezone),
+		Title:       aws.String(activity.Title),
+		ActivityUrl: aws.String(activityURL),
+		Emoji:       aws.String(kind.Emoji),
+		Noun:        aws.String(kind.Noun),
+	}
+}
+
+// newDigestEntry returns the update of the activity for a digest or a batch, tagged with the tags of the subscriber.
+func (s *Scrapper) newDigestEntry(link *domain.Link, tags []string, activity *domain.Activity) bottypes.DigestEntry {
+	update := s.newLinkUpdate(link, activity, nil, "")
+
+	return bottypes.DigestEntry{
+		Url:         update.Url,
+		Tags:        utils.SliceStringPtr(tags),
+		Description: update.Description,
+		CreatedAt:   update.СreatedAt,
+		UserName:    update.UserName,
+		Type:        update.Type,
+		Noun:        update.Noun,
 	}
+}
 
-	var activities []*domain.Activity
+// parseSubscriberFilters parses the filters of every subscriber.
+// Filters are validated when a link is added, so a failure here means
+// the stored value is outdated and the subscriber gets every activity.
+func (s *Scrapper) parseSubscriberFilters(subscribers []*domain.Link) map[int64]domain.Filters {
+	filters := make(map[int64]domain.Filters, len(subscribers))
 
-	if repo.UpdatedAt.After(link.LastCheck) {
-		activity, err := s.gitHubClient.GetActivity(ctx, repo, link.LastCheck)
+	for _, subscriber := range subscribers {
+		parsed, err := domain.ParseFilters(subscriber.Filters, s.providers.ActivityTypes())
 		if err != nil {
-			s.logger.Error("Failed to get activity", "error", err)
-			return nil, fmt.Errorf("failed to get activity: %w", err)
+			s.logger.Warn("Invalid stored filters, ignoring them", "chatID", subscriber.UserAddID, "error", err)
+			continue
 		}
 
-		for _, act := range activity {
-			var activityType domain.ActivityType
-
-			switch act.Type {
-			case github.ActivityTypeIssue:
-				activityType = domain.GitHubIssue
-			case github.ActivityTypePullRequest:
-				activityType = domain.GitHubPullRequest
-			case github.ActivityTypeRepository:
-				activityType = domain.GitHubPullRequest
-			default:
-				s.logger.Error("Unknown activity type", "type", act.Type)
-				return nil, fmt.Errorf("unknown activity type: %s", act.Type)
+		filters[subscriber.UserAddID] = parsed
+	}
+
+	return filters
+}
+
+// fetchActivity fetches the activity of the links from the providers of their types.
+func (s *Scrapper) fetchActivity(ctx context.Context, links []*domain.Link) map[string]provider.Result {
+	results := make(map[string]provider.Result, len(links))
+	byType := make(map[string][]*domain.Link)
+
+	for _, link := range links {
+		byType[link.Type] = append(byType[link.Type], link)
+	}
+
+	for linkType, typeLinks := range byType {
+		linkProvider, ok := s.providers.Get(linkType)
+		if !ok {
+			s.logger.Error("Unknown link type", "type", linkType)
+
+			for _, link := range typeLinks {
+				results[link.URL] = provider.Result{Err: fmt.Errorf("unknown link type: %s", linkType)}
 			}
 
-			activities = append(activities, domain.NewActivity(activityType, act.Title, act.CreatedAt, act.Body, act.UserName))
+			continue
 		}
+
+		maps.Copy(results, linkProvider.FetchActivity(ctx, typeLinks))
 	}
 
-	return activities, nil
+	return results
 }
 
 func (s *Scrapper) scrappeLinksTask() {
 	s.logger.Info("Starting scrappeLinksTask")
 
+	start := time.Now()
+
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 
@@ -254,7 +464,9 @@ func (s *Scrapper) scrappeLinksTask() {
 
 	var wg sync.WaitGroup
 
-	offset := uint64(0)
+	// Links are paged by URL, so links added or removed during the run
+	// do not shift the pages and make the loop skip or repeat links.
+	cursor := ""
 
 	for {
 		select {
@@ -264,9 +476,9 @@ func (s *Scrapper) scrappeLinksTask() {
 
 			return
 		default:
-			links, err := s.repository.GetLinksPagination(ctx, offset, PaginationLimit)
+			links, err := s.repository.GetLinksAfter(ctx, cursor, PaginationLimit)
 			if err != nil {
-				s.logger.Error("Error getting links for pagination", "error", err)
+				s.logger.Error("Error getting links page", "cursor", cursor, "error", err)
 				wg.Wait()
 
 				return
@@ -285,15 +497,36 @@ func (s *Scrapper) scrappeLinksTask() {
 					return
 				}
 
-				for _, link := range links {
+				// Links claimed by another instance are skipped, they are checked there.
+				claimed, err := s.repository.ClaimLinks(ctx, links, DefaultClaimLease)
+				if err != nil {
+					s.logger.Error("Error claiming links", "error", err)
+					return
+				}
+
+				results := s.fetchActivity(ctx, claimed)
+
+				for _, link := range claimed {
 					if ctx.Err() != nil {
 						s.logger.Warn("Context error", "error", ctx.Err())
 						return
 					}
 
-					if err := s.processLink(ctx, link); err != nil {
+					// A link that could not be processed keeps its claim until the lease
+					// expires, the rest of the page is processed anyway.
+					if err := s.processLink(ctx, link, start, results); err != nil {
 						s.logger.Error("Error processing link", "url", link.URL, "error", err)
-						return
+						continue
+					}
+
+					// A broken link is saved together with the notification about it.
+					if link.Broken {
+						continue
+					}
+
+					// Until the next check the claim keeps the link away from other instances.
+					if err := s.repository.UpdateNextCheck(ctx, link); err != nil {
+						s.logger.Error("Error updating next check", "url", link.URL, "error", err)
 					}
 				}
 			}(links)
@@ -305,33 +538,274 @@ func (s *Scrapper) scrappeLinksTask() {
 				return
 			}
 
-			offset += PaginationLimit
+			cursor = links[len(links)-1].URL
 		}
 	}
 }
 
-func (s *Scrapper) processLink(ctx context.Context, link *domain.Link) error {
-	activities, err := s.getActivity(ctx, link)
+// processLink checks the link for activity and schedules its next check from the start of the tick.
+func (s *Scrapper) processLink(
+	ctx context.Context,
+	link *domain.Link,
+	start time.Time,
+	results map[string]provider.Result,
+) error {
+	result, ok := results[link.URL]
+	if !ok {
+		return fmt.Errorf("activity was not fetched for link: %s", link.URL)
+	}
+
+	activities, err := result.Activities, result.Err
+	if err != nil {
+		// The link is checked again on the first tick after the reset.
+		var rateLimitErr *provider.RateLimitError
+		if errors.As(err, &rateLimitErr) {
+			s.logger.Warn("Rate limit exhausted, postponing link", "url", link.URL, "reset", rateLimitErr.Reset, "error", err)
+			s.postpone(link, start, rateLimitErr.Reset)
+
+			return nil
+		}
+
+		return s.fail(ctx, link, start, err)
+	}
+
+	link.Failures = 0
+
+	if len(activities) == 0 {
+		s.schedule(link, start, false)
+		s.logger.Info("No new activities found for link", "url", link.URL, "nextCheck", link.NextCheck)
+
+		return s.updateMetadata(ctx, link, result.Metadata)
+	}
+
+	// The last check is moved to the start of the tick, so activities created while the link
+	// is checked are reported again on the next check and dropped there as already seen.
+	lastCheck := link.LastCheck
+
+	var fresh []*domain.Activity
+
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		unseen, err := s.filterSeen(ctx, link, activities)
+		if err != nil {
+			return err
+		}
+
+		fresh = unseen
+
+		if err := s.saveActivities(ctx, link, fresh); err != nil {
+			return err
+		}
+
+		link.LastCheck = start
+
+		if err := s.repository.UpdateLastCheck(ctx, link); err != nil {
+			s.logger.Error("Error updating last check", "error", err)
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
+		link.LastCheck = lastCheck
 		return err
 	}
 
+	s.schedule(link, start, len(fresh) != 0)
+
+	if err := s.updateMetadata(ctx, link, result.Metadata); err != nil {
+		return err
+	}
+
+	s.logger.Info("Successfully processed link", "url", link.URL)
+
+	return nil
+}
+
+// filterSeen drops the activities whose source was already notified with the same revision.
+func (s *Scrapper) filterSeen(ctx context.Context, link *domain.Link, activities []*domain.Activity) ([]*domain.Activity, error) {
+	sourceIDs := make([]string, 0, len(activities))
+
+	for _, activity := range activities {
+		if activity.SourceID != "" {
+			sourceIDs = append(sourceIDs, activity.SourceID)
+		}
+	}
+
+	if len(sourceIDs) == 0 {
+		return activities, nil
+	}
+
+	seen, err := s.activities.GetSeenRevisions(ctx, link, sourceIDs)
+	if err != nil {
+		s.logger.Error("Error getting seen activities", "error", err)
+		return nil, fmt.Errorf("error getting seen activities: %w", err)
+	}
+
+	fresh := make([]*domain.Activity, 0, len(activities))
+
+	for _, activity := range activities {
+		if revision, ok := seen[activity.SourceID]; ok && revision == activity.Revision {
+			s.logger.Info("Activity was already notified", "url", link.URL, "source", activity.SourceID)
+			continue
+		}
+
+		fresh = append(fresh, activity)
+	}
+
+	return fresh, nil
+}
+
+// saveActivities enqueues the updates of the fresh activities, marks them as seen and adds
+// them to the history of the link. The history keeps the activities skipped by the filters too.
+func (s *Scrapper) saveActivities(ctx context.Context, link *domain.Link, activities []*domain.Activity) error {
 	if len(activities) == 0 {
-		s.logger.Info("No new activities found for link", "url", link.URL)
 		return nil
 	}
 
-	if err := s.notifyBot(ctx, activities, link); err != nil {
-		s.logger.Error("Error notifying bot", "error", err)
+	if err := s.enqueueUpdates(ctx, activities, link); err != nil {
 		return err
 	}
 
-	if err := s.repository.UpdateLastCheck(ctx, link); err != nil {
-		s.logger.Error("Error updating last check", "error", err)
+	if err := s.activities.SaveSeenActivities(ctx, link, activities); err != nil {
+		s.logger.Error("Error saving seen activities", "error", err)
 		return err
 	}
 
-	s.logger.Info("Successfully processed link", "url", link.URL)
+	if err := s.activities.AddActivities(ctx, link, activities); err != nil {
+		s.logger.Error("Error adding activities to history", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// updateMetadata saves the link metadata returned by the provider if it differs from the stored one.
+// It is called only after all activities are enqueued, otherwise the next conditional
+// request would report the unsent activities as not modified.
+func (s *Scrapper) updateMetadata(ctx context.Context, link *domain.Link, metadata domain.LinkMetadata) error {
+	if link.Metadata.Equal(metadata) {
+		return nil
+	}
+
+	link.Metadata = metadata
+
+	if err := s.repository.UpdateLinkMetadata(ctx, link); err != nil {
+		s.logger.Error("Error updating link metadata", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+// schedule sets the next check of the link. The check interval is reset to the minimum
+// after activity and doubled while nothing changes, up to the maximum or the strictest
+// cap of the subscribers.
+func (s *Scrapper) schedule(link *domain.Link, start time.Time, active bool) {
+	interval := link.CheckInterval * 2
+	if active || interval < s.minCheckInterval {
+		interval = s.minCheckInterval
+	}
+
+	maxInterval := s.maxCheckInterval
+	if link.MaxCheckInterval > 0 && link.MaxCheckInterval < maxInterval {
+		maxInterval = max(link.MaxCheckInterval, s.minCheckInterval)
+	}
+
+	link.CheckInterval = min(interval, maxInterval)
+	link.NextCheck = start.Add(link.CheckInterval)
+}
+
+// postpone delays the next check of the link until the reset of an exhausted API limit.
+// The check interval is kept, as nothing is known about the activity of the link.
+func (s *Scrapper) postpone(link *domain.Link, start, reset time.Time) {
+	link.NextCheck = reset
+
+	if earliest := start.Add(s.minCheckInterval); link.NextCheck.Before(earliest) {
+		link.NextCheck = earliest
+	}
+}
+
+// fail records a failed check of the link. The link is marked broken and its subscribers
+// are notified once it no longer exists or too many checks in a row failed. Until then
+// the next check is backed off from the minimum interval, doubling with every failure.
+func (s *Scrapper) fail(ctx context.Context, link *domain.Link, start time.Time, checkErr error) error {
+	link.Failures++
+
+	gone := errors.Is(checkErr, provider.ErrGone)
+	if !gone && link.Failures < s.maxCheckFailures {
+		s.logger.Warn("Failed to check link", "url", link.URL, "failures", link.Failures, "error", checkErr)
+
+		backoff := s.minCheckInterval
+		for i := 1; i < link.Failures && backoff < s.maxCheckInterval; i++ {
+			backoff *= 2
+		}
+
+		link.NextCheck = start.Add(min(backoff, s.maxCheckInterval))
+
+		return nil
+	}
+
+	s.logger.Warn("Marking link as broken", "url", link.URL, "failures", link.Failures, "error", checkErr)
+
+	reason := fmt.Sprintf("%d checks in a row failed", link.Failures)
+	if gone {
+		reason = "the link no longer exists"
+	}
+
+	// The broken flag is saved with the notification, so subscribers are notified exactly once.
+	err := s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := s.enqueueBroken(ctx, link, start, reason); err != nil {
+			return err
+		}
+
+		link.Broken = true
+
+		if err := s.repository.UpdateNextCheck(ctx, link); err != nil {
+			s.logger.Error("Error marking link as broken", "error", err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		link.Broken = false
+		return err
+	}
+
+	return nil
+}
+
+// enqueueBroken stores an update telling every subscriber of the link that it is no longer checked.
+func (s *Scrapper) enqueueBroken(ctx context.Context, link *domain.Link, start time.Time, reason string) error {
+	subscribers, err := s.repository.GetSubscribersByLink(ctx, link)
+	if err != nil {
+		s.logger.Error("Error getting subscribers", "error", err)
+		return fmt.Errorf("error getting subscribers: %w", err)
+	}
+
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	chatIDs := make([]int64, len(subscribers))
+	for i, subscriber := range subscribers {
+		chatIDs[i] = subscriber.UserAddID
+	}
+
+	updateType := string(domain.LinkBroken)
+
+	update := bottypes.LinkUpdate{
+		TgChatIds:   utils.SliceInt64Ptr(chatIDs),
+		СreatedAt:   &start,
+		Type:        &updateType,
+		Url:         aws.String(link.URL),
+		Description: aws.String(reason),
+	}
+
+	if err := s.outbox.AddOutboxMessage(ctx, update); err != nil {
+		s.logger.Error("Error adding update to outbox", "error", err)
+		return fmt.Errorf("error adding update to outbox: %w", err)
+	}
 
 	return nil
 }
@@ -1,13 +1,16 @@
 package scrapper_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/application/scrapper"
 	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
@@ -15,33 +18,105 @@ import (
 	"github.com/AFK068/bot/pkg/client/stackoverflow"
 
 	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	providerMock "github.com/AFK068/bot/internal/application/provider/mocks"
 	scrapperMock "github.com/AFK068/bot/internal/application/scrapper/mocks"
 	repoMock "github.com/AFK068/bot/internal/domain/mocks"
-	botMock "github.com/AFK068/bot/internal/infrastructure/clients/bot/mocks"
 )
 
+// newTransactor returns a transactor that runs the function without a transaction.
+func newTransactor(t *testing.T) *scrapperMock.Transactor {
+	transactor := scrapperMock.NewTransactor(t)
+
+	transactor.On("WithTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		}).
+		Maybe()
+
+	return transactor
+}
+
+// newActivities returns an activity repository that has seen no activities yet and accepts the history.
+func newActivities(t *testing.T) *repoMock.ActivityRepository {
+	activities := repoMock.NewActivityRepository(t)
+
+	activities.On("GetSeenRevisions", mock.Anything, mock.Anything, mock.Anything).Return(map[string]string{}, nil).Maybe()
+	activities.On("SaveSeenActivities", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	activities.On("AddActivities", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return activities
+}
+
+// newDigests returns a digest repository with every chat in the immediate mode without quiet hours.
+func newDigests(t *testing.T) *repoMock.DigestRepository {
+	digests := repoMock.NewDigestRepository(t)
+
+	digests.On("GetChatsSettings", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+
+	return digests
+}
+
+// newConfig returns a config with the default check interval bounds, failure limit and burst threshold.
+func newConfig() *scrapper.Config {
+	return &scrapper.Config{
+		MinCheckInterval: scrapper.DefaultMinCheckInterval,
+		MaxCheckInterval: scrapper.DefaultMaxCheckInterval,
+		MaxCheckFailures: scrapper.DefaultMaxCheckFailures,
+		BurstThreshold:   scrapper.DefaultBurstThreshold,
+	}
+}
+
+// newRegistry registers the built-in providers over the mocked clients.
+func newRegistry(
+	t *testing.T,
+	stackoverflowClient provider.StackOverlowQuestionFetcher,
+	githubClient provider.GitHubRepoFetcher,
+) *provider.Registry {
+	registry, err := provider.NewRegistry(
+		provider.NewGitHubProvider(githubClient, logger.NewDiscardLogger()),
+		provider.NewStackOverflowProvider(stackoverflowClient, logger.NewDiscardLogger()),
+	)
+	require.NoError(t, err)
+
+	return registry
+}
+
+// claimAll lets the scrapper claim every link it pages through.
+func claimAll(repo *repoMock.ChatLinkRepository) {
+	repo.On("ClaimLinks", mock.Anything, mock.Anything, scrapper.DefaultClaimLease).
+		Return(func(_ context.Context, links []*domain.Link, _ time.Duration) ([]*domain.Link, error) {
+			return links, nil
+		})
+
+	repo.On("UpdateNextCheck", mock.Anything, mock.Anything).Return(nil).Maybe()
+}
+
 func Test_GitHubLink_Update_Success(t *testing.T) {
 	repo := repoMock.NewChatLinkRepository(t)
-	githubClient := scrapperMock.NewGitHubRepoFetcher(t)
-	stackoverflowClient := scrapperMock.NewStackOverlowQuestionFetcher(t)
-	botClient := botMock.NewService(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
 
 	testLink := &domain.Link{
+		ID:        7,
 		UserAddID: 123,
-		URL:       "https://stackoverflow.com/test/question",
+		URL:       "https://github.com/test/question",
 		Type:      domain.GithubType,
 		LastCheck: time.Now().Add(-1 * time.Hour),
 	}
 
-	repo.On("GetLinksPagination", mock.Anything, uint64(0), scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
 
 	githubRepo := &github.Repository{
 		UpdatedAt: time.Now().Add(-1 * time.Hour),
 	}
 
-	githubClient.On("GetRepo", mock.Anything, testLink.URL).Return(githubRepo, nil)
+	githubClient.On("RateLimit").Return(github.RateLimit{})
 
-	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck).Return([]*github.Activity{
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
 		{
 			Type:      github.ActivityTypeIssue,
 			Body:      "Test answer body",
@@ -50,16 +125,19 @@ func Test_GitHubLink_Update_Success(t *testing.T) {
 		},
 	}, nil)
 
-	repo.On("GetChatIDsByLink", mock.Anything, testLink).Return([]int64{123}, nil)
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
 
-	botClient.On("PostUpdates", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
-		return *update.Url == testLink.URL && (*update.TgChatIds)[0] == 123 &&
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Id == testLink.ID && *update.Url == testLink.URL && (*update.TgChatIds)[0] == 123 &&
 			*update.Description == "Test answer body" && *update.UserName == "TestUser"
 	})).Return(nil)
 
 	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
 
-	s, err := scrapper.NewScrapperScheduler(repo, stackoverflowClient, githubClient, botClient, logger.NewDiscardLogger())
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
 	assert.NoError(t, err)
 
 	s.Run(time.Second)
@@ -67,31 +145,87 @@ func Test_GitHubLink_Update_Success(t *testing.T) {
 
 	repo.AssertExpectations(t)
 	stackoverflowClient.AssertExpectations(t)
-	botClient.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubLink_OutboxFailure_KeepsLastCheck(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+	transactor := scrapperMock.NewTransactor(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{UpdatedAt: time.Now()}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{Type: github.ActivityTypeIssue, Body: "Body", CreatedAt: time.Now()},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	// The transaction is rolled back, so the error is returned as is.
+	transactor.On("WithTransaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, txFunc func(ctx context.Context) error) error {
+			return txFunc(ctx)
+		})
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, transactor,
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertNotCalled(t, "UpdateLastCheck", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "UpdateLinkMetadata", mock.Anything, mock.Anything)
 }
 
 func Test_GitHubLink_NoUpdate_Success(t *testing.T) {
 	repo := repoMock.NewChatLinkRepository(t)
-	githubClient := scrapperMock.NewGitHubRepoFetcher(t)
-	stackoverflowClient := scrapperMock.NewStackOverlowQuestionFetcher(t)
-	botClient := botMock.NewService(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
 
 	testLink := &domain.Link{
 		UserAddID: 123,
-		URL:       "https://stackoverflow.com/test/question",
+		URL:       "https://github.com/test/question",
 		Type:      domain.GithubType,
 		LastCheck: time.Now(),
 	}
 
-	repo.On("GetLinksPagination", mock.Anything, uint64(0), scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
 
 	githubRepo := &github.Repository{
 		UpdatedAt: time.Now().Add(-1 * time.Hour),
 	}
 
-	githubClient.On("GetRepo", mock.Anything, testLink.URL).Return(githubRepo, nil)
+	githubClient.On("RateLimit").Return(github.RateLimit{})
 
-	s, err := scrapper.NewScrapperScheduler(repo, stackoverflowClient, githubClient, botClient, logger.NewDiscardLogger())
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
 	assert.NoError(t, err)
 
 	s.Run(time.Second)
@@ -99,50 +233,61 @@ func Test_GitHubLink_NoUpdate_Success(t *testing.T) {
 
 	repo.AssertExpectations(t)
 	stackoverflowClient.AssertExpectations(t)
-	botClient.AssertExpectations(t)
+	outbox.AssertExpectations(t)
 }
 
 func Test_StackOverflowLink_Update_Success(t *testing.T) {
 	repo := repoMock.NewChatLinkRepository(t)
-	githubClient := scrapperMock.NewGitHubRepoFetcher(t)
-	stackoverflowClient := scrapperMock.NewStackOverlowQuestionFetcher(t)
-	botClient := botMock.NewService(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
 
 	testLink := &domain.Link{
 		UserAddID: 123,
-		URL:       "https://stackoverflow.com/test/question",
+		URL:       "https://stackoverflow.com/questions/123",
 		Type:      domain.StackoverflowType,
 		LastCheck: time.Now().Add(-1 * time.Hour),
 	}
 
-	repo.On("GetLinksPagination", mock.Anything, uint64(0), scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
 
 	question := &stackoverflow.Question{
+		ID:               123,
 		LastActivityDate: time.Now().Unix(),
 	}
 
-	stackoverflowClient.On("GetQuestion", mock.Anything, testLink.URL).Return(question, nil)
+	stackoverflowClient.On("Quota").Return(stackoverflow.Quota{})
 
-	stackoverflowClient.On("GetActivity", mock.Anything, question, testLink.LastCheck).Return([]*stackoverflow.Activity{
-		{
-			Type:      stackoverflow.ActivityTypeAnswer,
-			Body:      "Test answer body",
-			UserName:  "TestUser",
-			CreatedAt: time.Now().Unix(),
-			Tags:      []string{"test", "tags"},
+	stackoverflowClient.On("GetQuestions", mock.Anything, "stackoverflow.com", []int64{123}).Return([]*stackoverflow.Question{question}, nil)
+
+	stackoverflowClient.On(
+		"GetQuestionsActivity", mock.Anything, "stackoverflow.com", []*stackoverflow.Question{question}, testLink.LastCheck,
+	).Return(map[int64][]*stackoverflow.Activity{
+		123: {
+			{
+				Type:      stackoverflow.ActivityTypeAnswer,
+				Body:      "Test answer body",
+				UserName:  "TestUser",
+				CreatedAt: time.Now().Unix(),
+				Tags:      []string{"test", "tags"},
+			},
 		},
 	}, nil)
 
-	repo.On("GetChatIDsByLink", mock.Anything, testLink).Return([]int64{123}, nil)
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
 
-	botClient.On("PostUpdates", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
 		return *update.Url == testLink.URL && (*update.TgChatIds)[0] == 123 &&
 			*update.Description == "Test answer body" && *update.UserName == "TestUser"
 	})).Return(nil)
 
 	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
 
-	s, err := scrapper.NewScrapperScheduler(repo, stackoverflowClient, githubClient, botClient, logger.NewDiscardLogger())
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
 	assert.NoError(t, err)
 
 	s.Run(time.Second)
@@ -150,31 +295,38 @@ func Test_StackOverflowLink_Update_Success(t *testing.T) {
 
 	repo.AssertExpectations(t)
 	stackoverflowClient.AssertExpectations(t)
-	botClient.AssertExpectations(t)
+	outbox.AssertExpectations(t)
 }
 
 func Test_StackOverflowLink_NoUpdate_Success(t *testing.T) {
 	repo := repoMock.NewChatLinkRepository(t)
-	githubClient := scrapperMock.NewGitHubRepoFetcher(t)
-	stackoverflowClient := scrapperMock.NewStackOverlowQuestionFetcher(t)
-	botClient := botMock.NewService(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
 
 	testLink := &domain.Link{
 		UserAddID: 123,
-		URL:       "https://stackoverflow.com/test/question",
+		URL:       "https://stackoverflow.com/questions/123",
 		Type:      domain.StackoverflowType,
 		LastCheck: time.Now(),
 	}
 
-	repo.On("GetLinksPagination", mock.Anything, uint64(0), scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
 
 	question := &stackoverflow.Question{
+		ID:               123,
 		LastActivityDate: time.Now().Add(-1 * time.Hour).Unix(),
 	}
 
-	stackoverflowClient.On("GetQuestion", mock.Anything, testLink.URL).Return(question, nil)
+	stackoverflowClient.On("Quota").Return(stackoverflow.Quota{})
+
+	stackoverflowClient.On("GetQuestions", mock.Anything, "stackoverflow.com", []int64{123}).Return([]*stackoverflow.Question{question}, nil)
 
-	s, err := scrapper.NewScrapperScheduler(repo, stackoverflowClient, githubClient, botClient, logger.NewDiscardLogger())
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
 	assert.NoError(t, err)
 
 	s.Run(time.Second)
@@ -182,64 +334,1364 @@ func Test_StackOverflowLink_NoUpdate_Success(t *testing.T) {
 
 	repo.AssertExpectations(t)
 	stackoverflowClient.AssertExpectations(t)
-	botClient.AssertExpectations(t)
+	outbox.AssertExpectations(t)
 }
 
-func Test_Pagination_Success(t *testing.T) {
+func Test_GitHubLink_Update_Filters_Success(t *testing.T) {
 	repo := repoMock.NewChatLinkRepository(t)
-	githubClient := scrapperMock.NewGitHubRepoFetcher(t)
-	stackoverflowClient := scrapperMock.NewStackOverlowQuestionFetcher(t)
-	botClient := botMock.NewService(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
 
-	batch1 := make([]*domain.Link, 50)
-	batch2 := make([]*domain.Link, 50)
-	batch3 := make([]*domain.Link, 40)
+	testLink := &domain.Link{
+		UserAddID: 123,
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
 
-	for i := range batch1 {
-		batch1[i] = &domain.Link{
-			URL:       fmt.Sprintf("https://example.com/%d", i),
-			Type:      domain.GithubType,
-			LastCheck: time.Now(),
-		}
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
 
-		batch2[i] = &domain.Link{
-			URL:       fmt.Sprintf("https://example.com/%d", 50+i),
-			Type:      domain.GithubType,
-			LastCheck: time.Now(),
-		}
+	githubRepo := &github.Repository{
+		UpdatedAt: time.Now().Add(-1 * time.Hour),
 	}
 
-	for i := range batch3 {
-		batch3[i] = &domain.Link{
-			URL:       fmt.Sprintf("https://example.com/%d", 100+i),
-			Type:      domain.GithubType,
-			LastCheck: time.Now(),
-		}
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeIssue,
+			Body:      "Bump dependency",
+			UserName:  "dependabot",
+			CreatedAt: time.Now(),
+		},
+		{
+			Type:      github.ActivityTypePullRequest,
+			Body:      "Fix bug",
+			UserName:  "octocat",
+			Labels:    []string{"bug"},
+			CreatedAt: time.Now(),
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, Filters: []string{"-user:dependabot"}},
+		{UserAddID: 456, Filters: []string{"type:issue"}},
+		{UserAddID: 789, Filters: []string{"label:feature"}},
+	}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Description == "Bump dependency" && assert.ObjectsAreEqual([]int64{456}, *update.TgChatIds)
+	})).Return(nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Description == "Fix bug" && assert.ObjectsAreEqual([]int64{123}, *update.TgChatIds)
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_RegisteredProvider_Update_Success(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	gitlab := providerMock.NewProvider(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://gitlab.com/test/test",
+		Type:      "gitlab",
+		LastCheck: time.Now().Add(-1 * time.Hour),
 	}
 
-	repo.On("GetLinksPagination", mock.Anything, uint64(0), scrapper.PaginationLimit).Return(batch1, nil).Once()
-	repo.On("GetLinksPagination", mock.Anything, uint64(50), scrapper.PaginationLimit).Return(batch2, nil).Once()
-	repo.On("GetLinksPagination", mock.Anything, uint64(100), scrapper.PaginationLimit).Return(batch3, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+	claimAll(repo)
 
-	githubClient.On("GetRepo", mock.Anything, mock.Anything).Return(&github.Repository{
-		UpdatedAt: time.Now().Add(-1 * time.Hour),
-	}, nil).Times(140)
+	gitlab.On("Type").Return("gitlab")
+	gitlab.On("ActivityKinds").Return([]domain.ActivityKind{
+		{Type: "gitlab_merge_request", Emoji: "🔀", Noun: "MR"},
+		{Type: "gitlab_issue", Emoji: "🐛", Noun: "issue"},
+	})
+	gitlab.On("FetchActivity", mock.Anything, []*domain.Link{testLink}).Return(map[string]provider.Result{
+		testLink.URL: {
+			Activities: []*domain.Activity{
+				domain.NewActivity("gitlab_issue", "issue:1", "", "", time.Now(), "Crash on start", "octocat", nil),
+				domain.NewActivity("gitlab_merge_request", "merge_request:2", "", "", time.Now(), "Fix crash", "octocat", nil),
+			},
+		},
+	})
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, Filters: []string{"type:merge_request"}},
+	}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Description == "Fix crash" && *update.Type == "gitlab_merge_request" &&
+			*update.Emoji == "🔀" && *update.Noun == "MR" && assert.ObjectsAreEqual([]int64{123}, *update.TgChatIds)
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	registry, err := provider.NewRegistry(gitlab)
+	require.NoError(t, err)
 
 	s, err := scrapper.NewScrapperScheduler(
-		repo,
-		stackoverflowClient,
-		githubClient,
-		botClient,
-		logger.NewDiscardLogger(),
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t), registry, logger.NewDiscardLogger(),
 	)
 	assert.NoError(t, err)
 
-	s.Run(500 * time.Millisecond)
-	time.Sleep(1 * time.Second)
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_SeenActivities_Deduplicated(t *testing.T) {
+	tests := []struct {
+		name         string
+		seen         map[string]string
+		wantNotified []string
+	}{
+		{
+			name:         "Nothing seen",
+			seen:         map[string]string{},
+			wantNotified: []string{"Crash on start", "Fix crash"},
+		},
+		{
+			name:         "Seen with the same revision",
+			seen:         map[string]string{"issue:1": "a"},
+			wantNotified: []string{"Fix crash"},
+		},
+		{
+			name:         "Seen with another revision",
+			seen:         map[string]string{"issue:1": "b", "issue:2": "b"},
+			wantNotified: []string{"Crash on start"},
+		},
+		{
+			name: "Everything seen",
+			seen: map[string]string{"issue:1": "a", "issue:2": "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repoMock.NewChatLinkRepository(t)
+			activities := repoMock.NewActivityRepository(t)
+			githubClient := providerMock.NewGitHubRepoFetcher(t)
+			stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+			outbox := repoMock.NewOutboxRepository(t)
+
+			lastCheck := time.Now().Add(-1 * time.Hour)
+			testLink := &domain.Link{
+				URL:       "https://github.com/test/test",
+				Type:      domain.GithubType,
+				LastCheck: lastCheck,
+			}
+
+			repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil).Once()
+			repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+			claimAll(repo)
+
+			githubRepo := &github.Repository{UpdatedAt: time.Now()}
+
+			githubClient.On("RateLimit").Return(github.RateLimit{})
+			githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+			githubClient.On("GetActivity", mock.Anything, githubRepo, lastCheck, mock.Anything).Return([]*github.Activity{
+				{Type: github.ActivityTypeIssue, ID: "issue:1", Revision: "a", Body: "Crash on start", CreatedAt: time.Now()},
+				{Type: github.ActivityTypePullRequest, ID: "issue:2", Revision: "b", Body: "Fix crash", CreatedAt: time.Now()},
+			}, nil)
+
+			activities.On("GetSeenRevisions", mock.Anything, testLink, []string{"issue:1", "issue:2"}).Return(tt.seen, nil)
+
+			var notified []string
+
+			if len(tt.wantNotified) != 0 {
+				repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
+
+				outbox.On("AddOutboxMessage", mock.Anything, mock.Anything).
+					Run(func(args mock.Arguments) {
+						notified = append(notified, *args.Get(1).(bottypes.LinkUpdate).Description)
+					}).
+					Return(nil)
+
+				activities.On("SaveSeenActivities", mock.Anything, testLink, mock.MatchedBy(func(saved []*domain.Activity) bool {
+					return len(saved) == len(tt.wantNotified)
+				})).Return(nil)
+				activities.On("AddActivities", mock.Anything, testLink, mock.MatchedBy(func(added []*domain.Activity) bool {
+					return len(added) == len(tt.wantNotified)
+				})).Return(nil)
+			}
+
+			repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+			s, err := scrapper.NewScrapperScheduler(
+				newConfig(), repo, activities, newDigests(t), outbox, newTransactor(t),
+				newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+			)
+			assert.NoError(t, err)
+
+			s.Run(time.Second)
+			time.Sleep(2 * time.Second)
+
+			err = s.Stop()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantNotified, notified)
+			// The last check is moved to the start of the tick even if everything was seen.
+			assert.WithinDuration(t, time.Now(), testLink.LastCheck, 3*time.Second)
+		})
+	}
+}
+
+func Test_GitHubLink_Update_OnlyNewSubscribers(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-2 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{
+		UpdatedAt: time.Now(),
+	}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeIssue,
+			Body:      "Test issue body",
+			UserName:  "TestUser",
+			CreatedAt: time.Now().Add(-1 * time.Hour),
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, LastCheck: testLink.LastCheck},
+		{UserAddID: 456, LastCheck: time.Now()},
+	}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Url == testLink.URL && assert.ObjectsAreEqual([]int64{123}, *update.TgChatIds)
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubLink_Update_MutedSubscribers(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-2 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{
+		UpdatedAt: time.Now(),
+	}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeIssue,
+			Title:     "Fix bug",
+			Body:      "Test issue body",
+			UserName:  "TestUser",
+			CreatedAt: time.Now().Add(-1 * time.Hour),
+			URL:       "https://github.com/test/test/issues/1",
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, LastCheck: testLink.LastCheck},
+		{UserAddID: 456, LastCheck: testLink.LastCheck, MutedUntil: time.Now().Add(time.Hour)},
+		{UserAddID: 789, LastCheck: testLink.LastCheck, MutedUntil: time.Now().Add(-time.Hour)},
+	}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return assert.ObjectsAreEqual([]int64{123, 789}, *update.TgChatIds) &&
+			*update.Title == "Fix bug" && *update.ActivityUrl == "https://github.com/test/test/issues/1"
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
 
 	err = s.Stop()
 	assert.NoError(t, err)
 
-	repo.AssertNumberOfCalls(t, "GetLinksPagination", 3)
 	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubLink_Update_DigestSubscribers(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+	digests := repoMock.NewDigestRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-2 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{
+		UpdatedAt: time.Now(),
+	}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeIssue,
+			Body:      "Test issue body",
+			UserName:  "TestUser",
+			CreatedAt: time.Now().Add(-1 * time.Hour),
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, LastCheck: testLink.LastCheck},
+		{UserAddID: 456, LastCheck: testLink.LastCheck, Tags: []string{"work"}},
+	}, nil)
+
+	digests.On("GetChatsSettings", mock.Anything, []int64{123, 456}).Return(map[int64]*domain.ChatSettings{
+		456: {ChatID: 456, DigestMode: domain.DigestHourly},
+	}, nil)
+
+	digests.On("AddDigestEntry", mock.Anything, int64(456), mock.MatchedBy(func(entry bottypes.DigestEntry) bool {
+		return *entry.Url == testLink.URL && *entry.UserName == "TestUser" && assert.ObjectsAreEqual([]string{"work"}, *entry.Tags)
+	})).Return(nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Url == testLink.URL && assert.ObjectsAreEqual([]int64{123}, *update.TgChatIds)
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), digests, outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	digests.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubLink_Update_QuietHours(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+	digests := repoMock.NewDigestRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-2 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{
+		UpdatedAt: time.Now(),
+	}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeIssue,
+			Body:      "Test issue body",
+			CreatedAt: time.Now().Add(-1 * time.Hour),
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, LastCheck: testLink.LastCheck},
+		{UserAddID: 456, LastCheck: testLink.LastCheck, Urgent: true},
+		{UserAddID: 789, LastCheck: testLink.LastCheck},
+	}, nil)
+
+	// The quiet hours of the first two chats last the whole day.
+	quiet := func(chatID int64) *domain.ChatSettings {
+		return &domain.ChatSettings{ChatID: chatID, DigestMode: domain.DigestImmediate, Timezone: "UTC", QuietEnd: 24 * time.Hour}
+	}
+
+	digests.On("GetChatsSettings", mock.Anything, []int64{123, 456, 789}).Return(map[int64]*domain.ChatSettings{
+		123: quiet(123),
+		456: quiet(456),
+		789: {ChatID: 789, DigestMode: domain.DigestImmediate, Timezone: "Europe/Berlin"},
+	}, nil)
+
+	digests.On("AddDigestEntry", mock.Anything, int64(123), mock.Anything).Return(nil)
+	digests.On("UpdateNextDigest", mock.Anything, mock.MatchedBy(func(settings *domain.ChatSettings) bool {
+		return settings.ChatID == 123 && settings.NextDigestAt.Equal(time.Now().UTC().Truncate(24*time.Hour).Add(24*time.Hour))
+	})).Return(nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Timezone == "Europe/Berlin" && assert.ObjectsAreEqual([]int64{789}, *update.TgChatIds)
+	})).Return(nil)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Timezone == "UTC" && assert.ObjectsAreEqual([]int64{456}, *update.TgChatIds)
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), digests, outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	digests.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubLink_Update_Burst(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-2 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{
+		UpdatedAt: time.Now(),
+	}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{Type: github.ActivityTypeIssue, Body: "First", UserName: "alice", CreatedAt: time.Now().Add(-time.Hour)},
+		{Type: github.ActivityTypePullRequest, Body: "Second", UserName: "bob", CreatedAt: time.Now().Add(-time.Hour)},
+		{Type: github.ActivityTypeIssue, Body: "Third", UserName: "bob", CreatedAt: time.Now().Add(-time.Hour)},
+	}, nil)
+
+	// The first two chats get every activity and the last one only the activity of alice.
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, LastCheck: testLink.LastCheck},
+		{UserAddID: 456, LastCheck: testLink.LastCheck},
+		{UserAddID: 789, LastCheck: testLink.LastCheck, Filters: []string{"user:alice"}},
+	}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		if *update.Type != string(domain.LinkBatch) || !assert.ObjectsAreEqual([]int64{123, 456}, *update.TgChatIds) {
+			return false
+		}
+
+		descriptions := make([]string, len(*update.Batch))
+		for i, entry := range *update.Batch {
+			descriptions[i] = *entry.Description
+		}
+
+		return *update.Url == testLink.URL && assert.ObjectsAreEqual([]string{"First", "Second", "Third"}, descriptions)
+	})).Return(nil).Once()
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Description == "First" && assert.ObjectsAreEqual([]int64{789}, *update.TgChatIds)
+	})).Return(nil).Once()
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	cfg := newConfig()
+	cfg.BurstThreshold = 2
+
+	s, err := scrapper.NewScrapperScheduler(
+		cfg, repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubLink_RateLimitExhausted(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{
+		Limit:     60,
+		Remaining: 0,
+		Reset:     time.Now().Add(time.Hour),
+	})
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	githubClient.AssertNotCalled(t, "GetRepo", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "UpdateLastCheck", mock.Anything, mock.Anything)
+}
+
+func Test_StackOverflowLink_QuotaExhausted(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://stackoverflow.com/questions/123",
+		Type:      domain.StackoverflowType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	stackoverflowClient.On("Quota").Return(stackoverflow.Quota{
+		Max:       300,
+		Remaining: 0,
+		Reset:     time.Now().Add(time.Hour),
+	})
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	stackoverflowClient.AssertNotCalled(t, "GetQuestions", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "UpdateLastCheck", mock.Anything, mock.Anything)
+}
+
+func Test_GitHubLink_NotModified(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+		Metadata: domain.LinkMetadata{
+			Validators: map[string]domain.Validator{"repo": {ETag: `"abc"`}},
+		},
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, github.Validators{"repo": {ETag: `"abc"`}}).
+		Return(nil, github.ErrNotModified)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertNotCalled(t, "UpdateLinkMetadata", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "UpdateLastCheck", mock.Anything, mock.Anything)
+}
+
+func Test_GitHubLink_UpdateValidators(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test",
+		Type:      domain.GithubType,
+		LastCheck: time.Now(),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(github.Validators)["repo"] = github.Validator{ETag: `"abc"`}
+		}).
+		Return(&github.Repository{UpdatedAt: time.Now().Add(-1 * time.Hour)}, nil)
+
+	repo.On("UpdateLinkMetadata", mock.Anything, mock.MatchedBy(func(link *domain.Link) bool {
+		return link.URL == testLink.URL && link.Metadata.Validators["repo"].ETag == `"abc"`
+	})).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+}
+
+func Test_GitHubThreadLink_Update_Success(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test/pull/7",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetThreadActivity", mock.Anything, testLink.URL, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeReview,
+			Title:     "Fix bug",
+			Body:      "approved",
+			UserName:  "TestUser",
+			CreatedAt: time.Now(),
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{
+		{UserAddID: 123, Filters: []string{"type:review"}},
+		{UserAddID: 456, Filters: []string{"type:comment"}},
+	}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Type == string(domain.GitHubReview) && assert.ObjectsAreEqual([]int64{123}, *update.TgChatIds)
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	githubClient.AssertNotCalled(t, "GetRepo", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_GitHubReleasesLink_Update_Success(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:       "https://github.com/test/test/releases",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil)
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetReleaseActivity", mock.Anything, testLink.URL, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{
+			Type:      github.ActivityTypeRelease,
+			Title:     "v1.0.0",
+			Body:      "Release notes",
+			UserName:  "TestUser",
+			CreatedAt: time.Now(),
+		},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Type == string(domain.GitHubRelease) && *update.Description == "Release notes"
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_StackOverflowLinks_BatchedPerSite(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	lastCheck := time.Now().Add(-1 * time.Hour)
+
+	links := []*domain.Link{
+		{URL: "https://stackoverflow.com/questions/1", Type: domain.StackoverflowType, LastCheck: lastCheck},
+		{URL: "https://stackoverflow.com/questions/2", Type: domain.StackoverflowType, LastCheck: lastCheck.Add(time.Minute)},
+		{URL: "https://stackoverflow.com/questions/3", Type: domain.StackoverflowType, LastCheck: lastCheck},
+		{
+			URL:       "https://serverfault.com/questions/4",
+			Type:      domain.StackoverflowType,
+			LastCheck: lastCheck,
+			Metadata:  domain.LinkMetadata{Site: "serverfault.com"},
+		},
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(links, nil)
+	claimAll(repo)
+
+	stackoverflowClient.On("Quota").Return(stackoverflow.Quota{})
+
+	active := []*stackoverflow.Question{
+		{ID: 1, LastActivityDate: time.Now().Unix()},
+		{ID: 2, LastActivityDate: time.Now().Unix()},
+	}
+	inactive := &stackoverflow.Question{ID: 3, LastActivityDate: lastCheck.Add(-time.Hour).Unix()}
+
+	stackoverflowClient.On("GetQuestions", mock.Anything, "stackoverflow.com", []int64{1, 2, 3}).
+		Return(append(active, inactive), nil)
+	stackoverflowClient.On("GetQuestions", mock.Anything, "serverfault.com", []int64{4}).
+		Return([]*stackoverflow.Question{{ID: 4, LastActivityDate: lastCheck.Add(-time.Hour).Unix()}}, nil)
+
+	// The oldest check of the active questions bounds the activity request.
+	stackoverflowClient.On("GetQuestionsActivity", mock.Anything, "stackoverflow.com", active, lastCheck).
+		Return(map[int64][]*stackoverflow.Activity{
+			1: {{Type: stackoverflow.ActivityTypeAnswer, Body: "First answer", CreatedAt: time.Now().Unix()}},
+			2: {{Type: stackoverflow.ActivityTypeComment, Body: "Old comment", CreatedAt: lastCheck.Add(30 * time.Second).Unix()}},
+		}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, links[0]).Return([]*domain.Link{{UserAddID: 1, LastCheck: lastCheck}}, nil)
+
+	outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+		return *update.Url == links[0].URL && *update.Description == "First answer"
+	})).Return(nil)
+
+	repo.On("UpdateLastCheck", mock.Anything, links[0]).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertExpectations(t)
+	stackoverflowClient.AssertExpectations(t)
+	outbox.AssertExpectations(t)
+}
+
+func Test_Pagination_Success(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	batch1 := make([]*domain.Link, 50)
+	batch2 := make([]*domain.Link, 50)
+	batch3 := make([]*domain.Link, 40)
+
+	for i := range batch1 {
+		batch1[i] = &domain.Link{
+			URL:       fmt.Sprintf("https://github.com/test/repo%d", i),
+			Type:      domain.GithubType,
+			LastCheck: time.Now(),
+		}
+
+		batch2[i] = &domain.Link{
+			URL:       fmt.Sprintf("https://github.com/test/repo%d", 50+i),
+			Type:      domain.GithubType,
+			LastCheck: time.Now(),
+		}
+	}
+
+	for i := range batch3 {
+		batch3[i] = &domain.Link{
+			URL:       fmt.Sprintf("https://github.com/test/repo%d", 100+i),
+			Type:      domain.GithubType,
+			LastCheck: time.Now(),
+		}
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(batch1, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, batch1[len(batch1)-1].URL, scrapper.PaginationLimit).Return(batch2, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, batch2[len(batch2)-1].URL, scrapper.PaginationLimit).Return(batch3, nil).Once()
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+
+	githubClient.On("GetRepo", mock.Anything, mock.Anything, mock.Anything).Return(&github.Repository{
+		UpdatedAt: time.Now().Add(-1 * time.Hour),
+	}, nil).Times(140)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(),
+		repo,
+		newActivities(t),
+		newDigests(t),
+		outbox,
+		newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient),
+		logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(500 * time.Millisecond)
+	time.Sleep(1 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	repo.AssertNumberOfCalls(t, "GetLinksAfter", 3)
+	repo.AssertExpectations(t)
+}
+
+func Test_ClaimedByAnotherInstance_Skipped(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	claimedLink := &domain.Link{
+		URL:       "https://github.com/test/claimed",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	skippedLink := &domain.Link{
+		URL:       "https://github.com/test/skipped",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	links := []*domain.Link{claimedLink, skippedLink}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(links, nil)
+
+	// The second link is leased by another instance.
+	repo.On("ClaimLinks", mock.Anything, links, scrapper.DefaultClaimLease).Return([]*domain.Link{claimedLink}, nil)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+	githubClient.On("GetRepo", mock.Anything, claimedLink.URL, mock.Anything).Return(&github.Repository{
+		UpdatedAt: time.Now().Add(-2 * time.Hour),
+	}, nil)
+
+	repo.On("UpdateNextCheck", mock.Anything, mock.MatchedBy(func(link *domain.Link) bool {
+		return link.URL == claimedLink.URL && link.NextCheck.After(time.Now().Add(-time.Second))
+	})).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	githubClient.AssertNotCalled(t, "GetRepo", mock.Anything, skippedLink.URL, mock.Anything)
+	repo.AssertNotCalled(t, "UpdateNextCheck", mock.Anything, skippedLink)
+}
+
+func Test_ProcessingFailure_KeepsClaim(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	failedLink := &domain.Link{
+		URL:       "https://github.com/test/failed",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	nextLink := &domain.Link{
+		URL:       "https://github.com/test/next",
+		Type:      domain.GithubType,
+		LastCheck: time.Now().Add(-1 * time.Hour),
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{failedLink, nextLink}, nil)
+	claimAll(repo)
+
+	githubRepo := &github.Repository{UpdatedAt: time.Now()}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+	githubClient.On("GetRepo", mock.Anything, failedLink.URL, mock.Anything).Return(githubRepo, nil)
+	githubClient.On("GetActivity", mock.Anything, githubRepo, failedLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{Type: github.ActivityTypeIssue, Body: "Body", CreatedAt: time.Now()},
+	}, nil)
+	githubClient.On("GetRepo", mock.Anything, nextLink.URL, mock.Anything).Return(&github.Repository{
+		UpdatedAt: time.Now().Add(-2 * time.Hour),
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, failedLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.Anything).Return(assert.AnError)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	// The link is retried by any instance once the lease expires,
+	// the rest of the page is processed anyway.
+	repo.AssertNotCalled(t, "UpdateNextCheck", mock.Anything, failedLink)
+	repo.AssertCalled(t, "UpdateNextCheck", mock.Anything, nextLink)
+}
+
+func Test_CheckFailure_BackedOff(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:           "https://github.com/test/test",
+		Type:          domain.GithubType,
+		LastCheck:     time.Now().Add(-1 * time.Hour),
+		CheckInterval: 10 * time.Minute,
+		Failures:      2,
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+	claimAll(repo)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(nil, assert.AnError)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	before := time.Now()
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	// The third failure in a row waits four times the minimum interval.
+	assert.Equal(t, 3, testLink.Failures)
+	assert.False(t, testLink.Broken)
+	assert.Equal(t, 10*time.Minute, testLink.CheckInterval)
+	assert.WithinDuration(t, before.Add(4*scrapper.DefaultMinCheckInterval), testLink.NextCheck, 2*time.Second)
+	repo.AssertCalled(t, "UpdateNextCheck", mock.Anything, testLink)
+	outbox.AssertNotCalled(t, "AddOutboxMessage", mock.Anything, mock.Anything)
+}
+
+func Test_CheckFailure_MarkedBroken(t *testing.T) {
+	tests := []struct {
+		name        string
+		link        *domain.Link
+		setup       func(github *providerMock.GitHubRepoFetcher, stackoverflow *providerMock.StackOverlowQuestionFetcher)
+		description string
+	}{
+		{
+			name: "too many failures in a row",
+			link: &domain.Link{
+				URL:      "https://github.com/test/test",
+				Type:     domain.GithubType,
+				Failures: scrapper.DefaultMaxCheckFailures - 1,
+			},
+			setup: func(githubClient *providerMock.GitHubRepoFetcher, _ *providerMock.StackOverlowQuestionFetcher) {
+				githubClient.On("RateLimit").Return(github.RateLimit{})
+				githubClient.On("GetRepo", mock.Anything, mock.Anything, mock.Anything).Return(nil, assert.AnError)
+			},
+			description: fmt.Sprintf("%d checks in a row failed", scrapper.DefaultMaxCheckFailures),
+		},
+		{
+			name: "deleted repository",
+			link: &domain.Link{
+				URL:  "https://github.com/test/test",
+				Type: domain.GithubType,
+			},
+			setup: func(githubClient *providerMock.GitHubRepoFetcher, _ *providerMock.StackOverlowQuestionFetcher) {
+				githubClient.On("RateLimit").Return(github.RateLimit{})
+				githubClient.On("GetRepo", mock.Anything, mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("%w: %w", github.ErrFailedToGetRepository, github.ErrNotFound))
+			},
+			description: "the link no longer exists",
+		},
+		{
+			name: "deleted question",
+			link: &domain.Link{
+				URL:  "https://stackoverflow.com/questions/1",
+				Type: domain.StackoverflowType,
+			},
+			setup: func(_ *providerMock.GitHubRepoFetcher, stackoverflowClient *providerMock.StackOverlowQuestionFetcher) {
+				stackoverflowClient.On("Quota").Return(stackoverflow.Quota{})
+				stackoverflowClient.On("GetQuestions", mock.Anything, "stackoverflow.com", []int64{1}).Return(nil, nil)
+			},
+			description: "the link no longer exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repoMock.NewChatLinkRepository(t)
+			githubClient := providerMock.NewGitHubRepoFetcher(t)
+			stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+			outbox := repoMock.NewOutboxRepository(t)
+
+			repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{tt.link}, nil).Once()
+			repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+			claimAll(repo)
+
+			tt.setup(githubClient, stackoverflowClient)
+
+			repo.On("GetSubscribersByLink", mock.Anything, tt.link).Return([]*domain.Link{{UserAddID: 123}, {UserAddID: 456}}, nil)
+
+			outbox.On("AddOutboxMessage", mock.Anything, mock.MatchedBy(func(update bottypes.LinkUpdate) bool {
+				return *update.Type == string(domain.LinkBroken) && *update.Url == tt.link.URL &&
+					*update.Description == tt.description && assert.ObjectsAreEqual([]int64{123, 456}, *update.TgChatIds)
+			})).Return(nil).Once()
+
+			s, err := scrapper.NewScrapperScheduler(
+				newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+				newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+			)
+			assert.NoError(t, err)
+
+			s.Run(time.Second)
+			time.Sleep(2 * time.Second)
+
+			err = s.Stop()
+			assert.NoError(t, err)
+
+			assert.True(t, tt.link.Broken)
+			repo.AssertNumberOfCalls(t, "UpdateNextCheck", 1)
+			outbox.AssertExpectations(t)
+		})
+	}
+}
+
+func Test_NextCheck_Schedule(t *testing.T) {
+	tests := []struct {
+		name             string
+		checkInterval    time.Duration
+		maxCheckInterval time.Duration
+		wantInterval     time.Duration
+	}{
+		{
+			name:         "new link starts at the minimum",
+			wantInterval: scrapper.DefaultMinCheckInterval,
+		},
+		{
+			name:          "backs off without activity",
+			checkInterval: time.Minute,
+			wantInterval:  2 * time.Minute,
+		},
+		{
+			name:          "capped by the maximum",
+			checkInterval: 45 * time.Minute,
+			wantInterval:  scrapper.DefaultMaxCheckInterval,
+		},
+		{
+			name:             "capped by a subscriber",
+			checkInterval:    10 * time.Minute,
+			maxCheckInterval: 5 * time.Minute,
+			wantInterval:     5 * time.Minute,
+		},
+		{
+			name:             "subscriber cap below the minimum",
+			checkInterval:    time.Minute,
+			maxCheckInterval: time.Second,
+			wantInterval:     scrapper.DefaultMinCheckInterval,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := repoMock.NewChatLinkRepository(t)
+			githubClient := providerMock.NewGitHubRepoFetcher(t)
+			stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+			outbox := repoMock.NewOutboxRepository(t)
+
+			testLink := &domain.Link{
+				URL:              "https://github.com/test/test",
+				Type:             domain.GithubType,
+				LastCheck:        time.Now().Add(-1 * time.Hour),
+				CheckInterval:    tt.checkInterval,
+				MaxCheckInterval: tt.maxCheckInterval,
+			}
+
+			// The link is rescheduled, so it is not due on the following ticks.
+			repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil).Once()
+			repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+			claimAll(repo)
+
+			githubClient.On("RateLimit").Return(github.RateLimit{})
+			githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(&github.Repository{
+				UpdatedAt: time.Now().Add(-2 * time.Hour),
+			}, nil)
+
+			s, err := scrapper.NewScrapperScheduler(
+				newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+				newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+			)
+			assert.NoError(t, err)
+
+			s.Run(time.Second)
+			time.Sleep(2 * time.Second)
+
+			err = s.Stop()
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantInterval, testLink.CheckInterval)
+			assert.WithinDuration(t, time.Now().Add(tt.wantInterval), testLink.NextCheck, 2*time.Second)
+			repo.AssertCalled(t, "UpdateNextCheck", mock.Anything, testLink)
+		})
+	}
+}
+
+func Test_NextCheck_ResetAfterActivity(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:           "https://github.com/test/test",
+		Type:          domain.GithubType,
+		LastCheck:     time.Now().Add(-1 * time.Hour),
+		CheckInterval: 30 * time.Minute,
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+	claimAll(repo)
+
+	githubRepo := &github.Repository{UpdatedAt: time.Now()}
+
+	githubClient.On("RateLimit").Return(github.RateLimit{})
+	githubClient.On("GetRepo", mock.Anything, testLink.URL, mock.Anything).Return(githubRepo, nil)
+	githubClient.On("GetActivity", mock.Anything, githubRepo, testLink.LastCheck, mock.Anything).Return([]*github.Activity{
+		{Type: github.ActivityTypeIssue, Body: "Body", CreatedAt: time.Now()},
+	}, nil)
+
+	repo.On("GetSubscribersByLink", mock.Anything, testLink).Return([]*domain.Link{{UserAddID: 123}}, nil)
+	outbox.On("AddOutboxMessage", mock.Anything, mock.Anything).Return(nil)
+	repo.On("UpdateLastCheck", mock.Anything, testLink).Return(nil)
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	assert.Equal(t, scrapper.DefaultMinCheckInterval, testLink.CheckInterval)
+	repo.AssertCalled(t, "UpdateNextCheck", mock.Anything, testLink)
+}
+
+func Test_NextCheck_PostponedUntilRateLimitReset(t *testing.T) {
+	repo := repoMock.NewChatLinkRepository(t)
+	githubClient := providerMock.NewGitHubRepoFetcher(t)
+	stackoverflowClient := providerMock.NewStackOverlowQuestionFetcher(t)
+	outbox := repoMock.NewOutboxRepository(t)
+
+	testLink := &domain.Link{
+		URL:           "https://github.com/test/test",
+		Type:          domain.GithubType,
+		LastCheck:     time.Now().Add(-1 * time.Hour),
+		CheckInterval: 10 * time.Minute,
+	}
+
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return([]*domain.Link{testLink}, nil).Once()
+	repo.On("GetLinksAfter", mock.Anything, "", scrapper.PaginationLimit).Return(nil, nil).Maybe()
+	claimAll(repo)
+
+	reset := time.Now().Add(30 * time.Minute)
+
+	githubClient.On("RateLimit").Return(github.RateLimit{Limit: 5000, Remaining: 0, Reset: reset})
+
+	s, err := scrapper.NewScrapperScheduler(
+		newConfig(), repo, newActivities(t), newDigests(t), outbox, newTransactor(t),
+		newRegistry(t, stackoverflowClient, githubClient), logger.NewDiscardLogger(),
+	)
+	assert.NoError(t, err)
+
+	s.Run(time.Second)
+	time.Sleep(2 * time.Second)
+
+	err = s.Stop()
+	assert.NoError(t, err)
+
+	// Nothing is known about the activity, so the interval is kept.
+	assert.Equal(t, 10*time.Minute, testLink.CheckInterval)
+	assert.Equal(t, reset, testLink.NextCheck)
+	repo.AssertCalled(t, "UpdateNextCheck", mock.Anything, testLink)
 }
@@ -0,0 +1,15 @@
+package config
+
+const (
+	DefaultKafkaTopic    = "link-updates"
+	DefaultKafkaDLQTopic = "link-updates-dlq"
+	DefaultKafkaGroupID  = "bot"
+)
+
+// Kafka is shared by the scrapper, which publishes link updates, and the bot, which consumes them.
+type Kafka struct {
+	Brokers  []string `yaml:"brokers" env:"KAFKA_BROKERS" env-separator:","`
+	Topic    string   `yaml:"topic" env:"KAFKA_TOPIC" env-default:"link-updates"`
+	DLQTopic string   `yaml:"dlq_topic" env:"KAFKA_DLQ_TOPIC" env-default:"link-updates-dlq"`
+	GroupID  string   `yaml:"group_id" env:"KAFKA_GROUP_ID" env-default:"bot"`
+}
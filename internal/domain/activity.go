@@ -1,13 +1,18 @@
 package domain
 
-import (
-	"time"
-
-	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
-)
+import "time"
 
+// ActivityType is the type of an activity reported by a provider, prefixed with the link type.
+// It is sent to the bot as the type of the link update.
 type ActivityType string
 
+// LinkBroken is the type of the update telling the subscribers that a link can no longer be checked.
+const LinkBroken ActivityType = "link_broken"
+
+// LinkBatch is the type of the update collapsing a burst of activity of a link into one summary.
+const LinkBatch ActivityType = "batch"
+
+// Activity types of the built-in providers.
 const (
 	StackoverflowComment  ActivityType = "stackoverflow_comment"
 	StackoverflowAnswer   ActivityType = "stackoverflow_answer"
@@ -16,53 +21,68 @@ const (
 	GitHubRepository  ActivityType = "github_repository"
 	GitHubIssue       ActivityType = "github_issue"
 	GitHubPullRequest ActivityType = "github_pull_request"
+
+	GitHubComment       ActivityType = "github_comment"
+	GitHubReviewComment ActivityType = "github_review_comment"
+	GitHubReview        ActivityType = "github_review"
+	GitHubLabel         ActivityType = "github_label"
+	GitHubState         ActivityType = "github_state"
+	GitHubCheck         ActivityType = "github_check"
+
+	GitHubRelease ActivityType = "github_release"
+	GitHubTag     ActivityType = "github_tag"
+	GitHubCommit  ActivityType = "github_commit"
 )
 
+// ActivityKind is an activity type reported by a provider with the emoji and the noun that show
+// its updates in messages, such as "🐛" and "issue".
+type ActivityKind struct {
+	Type  ActivityType
+	Emoji string
+	Noun  string
+}
+
+// Activity is a change of a tracked link. SourceID identifies the changed object within
+// the link and Revision marks its content, so an activity is notified again only
+// if its revision changes. Activities without a source ID are always notified.
+// URL links to the changed object, it is empty if the provider knows no better page than the link.
 type Activity struct {
 	Type      ActivityType
+	SourceID  string
+	Revision  string
 	Title     string
 	CreatedAt time.Time
 	Body      string
 	UserName  string
+	Labels    []string
+	URL       string
 }
 
 func NewActivity(
 	activityType ActivityType,
+	sourceID, revision string,
 	title string,
 	createdAt time.Time,
 	body string,
 	userName string,
+	labels []string,
 ) *Activity {
 	return &Activity{
 		Type:      activityType,
+		SourceID:  sourceID,
+		Revision:  revision,
 		Title:     title,
 		CreatedAt: createdAt,
 		Body:      body,
 		UserName:  userName,
+		Labels:    labels,
 	}
 }
 
-func (a *Activity) MapActivityTypeToBotAPI() *bottypes.LinkUpdateType {
-	switch a.Type {
-	case StackoverflowComment:
-		stackoverflowComment := bottypes.StackoverflowComment
-		return &stackoverflowComment
-	case StackoverflowAnswer:
-		stackoverflowAnswer := bottypes.StackoverflowAnswer
-		return &stackoverflowAnswer
-	case StackoverflowQuestion:
-		stackoverflowQuestion := bottypes.StackoverflowQuestion
-		return &stackoverflowQuestion
-	case GitHubRepository:
-		githubRepository := bottypes.GithubRepository
-		return &githubRepository
-	case GitHubIssue:
-		githubIssue := bottypes.GithubIssue
-		return &githubIssue
-	case GitHubPullRequest:
-		githubPullRequest := bottypes.GithubPullRequest
-		return &githubPullRequest
-	}
-
-	return nil
+// ActivityQuery selects the stored activities of a link, the newest first.
+// Zero Since and empty Types match every activity, zero Limit returns all of them.
+type ActivityQuery struct {
+	Since time.Time
+	Types []ActivityType
+	Limit uint64
 }
@@ -15,3 +15,27 @@ type LinkTypeError struct {
 func (e *LinkTypeError) Error() string {
 	return e.Message
 }
+
+type FilterValidateError struct {
+	Message string
+}
+
+func (e *FilterValidateError) Error() string {
+	return e.Message
+}
+
+type ActivityQueryValidateError struct {
+	Message string
+}
+
+func (e *ActivityQueryValidateError) Error() string {
+	return e.Message
+}
+
+type SettingsValidateError struct {
+	Message string
+}
+
+func (e *SettingsValidateError) Error() string {
+	return e.Message
+}
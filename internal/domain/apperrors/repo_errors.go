@@ -23,3 +23,11 @@ type LinkIsNotExistError struct {
 func (e *LinkIsNotExistError) Error() string {
 	return e.Message
 }
+
+type OutboxMessageIsNotExistError struct {
+	Message string
+}
+
+func (e *OutboxMessageIsNotExistError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,185 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/pkg/tghtml"
+)
+
+// Filter syntax:
+//
+//	user:octocat       activity author is octocat
+//	-user:dependabot   activity author is not dependabot
+//	type:pull_request  activity type (with or without the provider prefix)
+//	label:bug          issue label or question tag
+//	text:panic         title or body contains the substring (case-insensitive)
+//	text:~^fix\b       title or body matches the regular expression
+//
+// A term without a key is a shorthand for text:<term>.
+// Positive filters with the same key are OR'ed, different keys are AND'ed,
+// and any matching negated filter rejects the activity.
+
+type FilterKey string
+
+const (
+	FilterKeyUser  FilterKey = "user"
+	FilterKeyType  FilterKey = "type"
+	FilterKeyLabel FilterKey = "label"
+	FilterKeyText  FilterKey = "text"
+
+	filterNegationPrefix = "-"
+	filterRegexpPrefix   = "~"
+)
+
+type Filter struct {
+	Key     FilterKey
+	Value   string
+	Negated bool
+	pattern *regexp.Regexp
+}
+
+type Filters []*Filter
+
+// ParseFilter parses a filter term. A type filter must match one of the given activity types.
+func ParseFilter(raw string, activityTypes []ActivityType) (*Filter, error) {
+	term := strings.TrimSpace(raw)
+	if term == "" {
+		return nil, &apperrors.FilterValidateError{Message: "empty filter"}
+	}
+
+	filter := &Filter{}
+
+	if strings.HasPrefix(term, filterNegationPrefix) {
+		filter.Negated = true
+		term = strings.TrimPrefix(term, filterNegationPrefix)
+	}
+
+	key, value, found := strings.Cut(term, ":")
+	if !found {
+		key, value = string(FilterKeyText), term
+	}
+
+	filter.Key = FilterKey(strings.ToLower(key))
+	filter.Value = value
+
+	if filter.Value == "" {
+		return nil, &apperrors.FilterValidateError{Message: fmt.Sprintf("filter %q has empty value", raw)}
+	}
+
+	switch filter.Key {
+	case FilterKeyUser, FilterKeyLabel:
+	case FilterKeyType:
+		if !isKnownActivityType(activityTypes, filter.Value) {
+			return nil, &apperrors.FilterValidateError{Message: fmt.Sprintf("filter %q has unknown activity type", raw)}
+		}
+	case FilterKeyText:
+		if strings.HasPrefix(filter.Value, filterRegexpPrefix) {
+			pattern, err := regexp.Compile("(?i)" + strings.TrimPrefix(filter.Value, filterRegexpPrefix))
+			if err != nil {
+				return nil, &apperrors.FilterValidateError{Message: fmt.Sprintf("filter %q has invalid regexp: %v", raw, err)}
+			}
+
+			filter.pattern = pattern
+		}
+	default:
+		return nil, &apperrors.FilterValidateError{Message: fmt.Sprintf("filter %q has unknown key %q", raw, key)}
+	}
+
+	return filter, nil
+}
+
+func ParseFilters(raw []string, activityTypes []ActivityType) (Filters, error) {
+	filters := make(Filters, 0, len(raw))
+
+	for _, term := range raw {
+		filter, err := ParseFilter(term, activityTypes)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// Match reports whether the activity passes all filters.
+// An empty filter set matches every activity.
+func (fs Filters) Match(activity *Activity) bool {
+	positive := make(map[FilterKey]bool)
+
+	for _, filter := range fs {
+		matched := filter.match(activity)
+
+		if filter.Negated {
+			if matched {
+				return false
+			}
+
+			continue
+		}
+
+		positive[filter.Key] = positive[filter.Key] || matched
+	}
+
+	for _, matched := range positive {
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f *Filter) match(activity *Activity) bool {
+	switch f.Key {
+	case FilterKeyUser:
+		return strings.EqualFold(activity.UserName, f.Value)
+	case FilterKeyType:
+		return activityTypeMatches(activity.Type, f.Value)
+	case FilterKeyLabel:
+		for _, label := range activity.Labels {
+			if strings.EqualFold(label, f.Value) {
+				return true
+			}
+		}
+
+		return false
+	case FilterKeyText:
+		text := activity.Title + "\n" + tghtml.PlainText(activity.Body)
+
+		if f.pattern != nil {
+			return f.pattern.MatchString(text)
+		}
+
+		return strings.Contains(strings.ToLower(text), strings.ToLower(f.Value))
+	}
+
+	return false
+}
+
+func activityTypeMatches(activityType ActivityType, value string) bool {
+	value = strings.ToLower(value)
+
+	return string(activityType) == value || strings.HasSuffix(string(activityType), "_"+value)
+}
+
+// MatchActivityTypes returns the activity types matching the value given with or without the provider prefix.
+func MatchActivityTypes(activityTypes []ActivityType, value string) []ActivityType {
+	var matched []ActivityType
+
+	for _, activityType := range activityTypes {
+		if activityTypeMatches(activityType, value) {
+			matched = append(matched, activityType)
+		}
+	}
+
+	return matched
+}
+
+func isKnownActivityType(activityTypes []ActivityType, value string) bool {
+	return len(MatchActivityTypes(activityTypes, value)) != 0
+}
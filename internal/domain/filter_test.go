@@ -0,0 +1,80 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+)
+
+var activityTypes = []domain.ActivityType{domain.GitHubIssue, domain.GitHubPullRequest, domain.StackoverflowAnswer}
+
+func Test_Filters_Match(t *testing.T) {
+	activity := &domain.Activity{
+		Type:     domain.GitHubPullRequest,
+		Title:    "Fix panic in parser",
+		Body:     "Closes #42",
+		UserName: "octocat",
+		Labels:   []string{"bug", "parser"},
+	}
+
+	tests := []struct {
+		name    string
+		filters []string
+		want    bool
+	}{
+		{name: "No filters", filters: nil, want: true},
+		{name: "User match", filters: []string{"user:octocat"}, want: true},
+		{name: "User mismatch", filters: []string{"user:hubot"}, want: false},
+		{name: "Negated user", filters: []string{"-user:octocat"}, want: false},
+		{name: "Same key is OR", filters: []string{"user:hubot", "user:OctoCat"}, want: true},
+		{name: "Different keys are AND", filters: []string{"user:octocat", "label:feature"}, want: false},
+		{name: "Short type", filters: []string{"type:pull_request"}, want: true},
+		{name: "Full type", filters: []string{"type:github_issue"}, want: false},
+		{name: "Label", filters: []string{"label:bug"}, want: true},
+		{name: "Text substring", filters: []string{"text:PANIC"}, want: true},
+		{name: "Bare term is text", filters: []string{"#42"}, want: true},
+		{name: "Text regexp", filters: []string{`text:~^fix\b`}, want: true},
+		{name: "Negated text regexp", filters: []string{"-text:~wip|draft"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := domain.ParseFilters(tt.filters, activityTypes)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, filters.Match(activity))
+		})
+	}
+}
+
+func Test_ParseFilter_Failure(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter string
+	}{
+		{name: "Empty", filter: " "},
+		{name: "Empty value", filter: "user:"},
+		{name: "Unknown key", filter: "author:octocat"},
+		{name: "Unknown type", filter: "type:deployment"},
+		{name: "Invalid regexp", filter: "text:~(unclosed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.ParseFilter(tt.filter, activityTypes)
+
+			require.Error(t, err)
+			assert.IsType(t, &apperrors.FilterValidateError{}, err)
+		})
+	}
+}
+
+func Test_MatchActivityTypes(t *testing.T) {
+	assert.Equal(t, []domain.ActivityType{domain.GitHubIssue}, domain.MatchActivityTypes(activityTypes, "issue"))
+	assert.Equal(t, []domain.ActivityType{domain.StackoverflowAnswer}, domain.MatchActivityTypes(activityTypes, "StackOverflow_Answer"))
+	assert.Empty(t, domain.MatchActivityTypes(activityTypes, "release"))
+}
@@ -1,6 +1,9 @@
 package domain
 
-import "time"
+import (
+	"maps"
+	"time"
+)
 
 var (
 	StackoverflowType = "stackoverflow"
@@ -8,10 +11,47 @@ var (
 )
 
 type Link struct {
+	// ID is the identifier of the tracked link shared by all subscribers.
+	ID        int64
 	UserAddID int64
 	URL       string
 	Type      string
 	Tags      []string
 	Filters   []string
 	LastCheck time.Time
+	NextCheck time.Time
+	Metadata  LinkMetadata
+
+	// CheckInterval is the adaptive time between two checks of the link.
+	CheckInterval time.Duration
+	// MaxCheckInterval caps the check interval for the subscriber, or for the link
+	// the strictest cap of its subscribers. Zero means no cap.
+	MaxCheckInterval time.Duration
+	// Urgent updates of the link reach the subscriber during its quiet hours.
+	Urgent bool
+	// MutedUntil is the time until which the updates of the link are not sent to the subscriber.
+	MutedUntil time.Time
+
+	// Failures is the number of checks of the link failed in a row.
+	Failures int
+	// Broken is set when the link can no longer be checked, it is skipped by the scrapper.
+	Broken bool
+}
+
+// LinkMetadata is provider specific state of the link shared by all subscribers.
+type LinkMetadata struct {
+	// Site is the Stack Exchange API site of the link.
+	Site string `json:"site,omitempty"`
+	// Validators holds the cache validators of the last response per endpoint.
+	Validators map[string]Validator `json:"validators,omitempty"`
+}
+
+// Equal reports whether both metadata hold the same state.
+func (m LinkMetadata) Equal(other LinkMetadata) bool {
+	return m.Site == other.Site && maps.Equal(m.Validators, other.Validators)
+}
+
+type Validator struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
 }
@@ -4,6 +4,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	domain "github.com/AFK068/bot/internal/domain"
 	mock "github.com/stretchr/testify/mock"
@@ -79,6 +80,66 @@ func (_c *ChatLinkRepository_CheckUserExistence_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// ClaimLinks provides a mock function with given fields: ctx, links, lease
+func (_m *ChatLinkRepository) ClaimLinks(ctx context.Context, links []*domain.Link, lease time.Duration) ([]*domain.Link, error) {
+	ret := _m.Called(ctx, links, lease)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimLinks")
+	}
+
+	var r0 []*domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Link, time.Duration) ([]*domain.Link, error)); ok {
+		return rf(ctx, links, lease)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*domain.Link, time.Duration) []*domain.Link); ok {
+		r0 = rf(ctx, links, lease)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*domain.Link, time.Duration) error); ok {
+		r1 = rf(ctx, links, lease)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatLinkRepository_ClaimLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimLinks'
+type ChatLinkRepository_ClaimLinks_Call struct {
+	*mock.Call
+}
+
+// ClaimLinks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - links []*domain.Link
+//   - lease time.Duration
+func (_e *ChatLinkRepository_Expecter) ClaimLinks(ctx interface{}, links interface{}, lease interface{}) *ChatLinkRepository_ClaimLinks_Call {
+	return &ChatLinkRepository_ClaimLinks_Call{Call: _e.mock.On("ClaimLinks", ctx, links, lease)}
+}
+
+func (_c *ChatLinkRepository_ClaimLinks_Call) Run(run func(ctx context.Context, links []*domain.Link, lease time.Duration)) *ChatLinkRepository_ClaimLinks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*domain.Link), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ChatLinkRepository_ClaimLinks_Call) Return(_a0 []*domain.Link, _a1 error) *ChatLinkRepository_ClaimLinks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatLinkRepository_ClaimLinks_Call) RunAndReturn(run func(context.Context, []*domain.Link, time.Duration) ([]*domain.Link, error)) *ChatLinkRepository_ClaimLinks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteChat provides a mock function with given fields: ctx, uid
 func (_m *ChatLinkRepository) DeleteChat(ctx context.Context, uid int64) error {
 	ret := _m.Called(ctx, uid)
@@ -233,6 +294,66 @@ func (_c *ChatLinkRepository_GetChatIDsByLink_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetLinksAfter provides a mock function with given fields: ctx, cursor, limit
+func (_m *ChatLinkRepository) GetLinksAfter(ctx context.Context, cursor string, limit uint64) ([]*domain.Link, error) {
+	ret := _m.Called(ctx, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinksAfter")
+	}
+
+	var r0 []*domain.Link
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) ([]*domain.Link, error)); ok {
+		return rf(ctx, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, uint64) []*domain.Link); ok {
+		r0 = rf(ctx, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Link)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, uint64) error); ok {
+		r1 = rf(ctx, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatLinkRepository_GetLinksAfter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinksAfter'
+type ChatLinkRepository_GetLinksAfter_Call struct {
+	*mock.Call
+}
+
+// GetLinksAfter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cursor string
+//   - limit uint64
+func (_e *ChatLinkRepository_Expecter) GetLinksAfter(ctx interface{}, cursor interface{}, limit interface{}) *ChatLinkRepository_GetLinksAfter_Call {
+	return &ChatLinkRepository_GetLinksAfter_Call{Call: _e.mock.On("GetLinksAfter", ctx, cursor, limit)}
+}
+
+func (_c *ChatLinkRepository_GetLinksAfter_Call) Run(run func(ctx context.Context, cursor string, limit uint64)) *ChatLinkRepository_GetLinksAfter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *ChatLinkRepository_GetLinksAfter_Call) Return(_a0 []*domain.Link, _a1 error) *ChatLinkRepository_GetLinksAfter_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatLinkRepository_GetLinksAfter_Call) RunAndReturn(run func(context.Context, string, uint64) ([]*domain.Link, error)) *ChatLinkRepository_GetLinksAfter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinksByTag provides a mock function with given fields: ctx, uid, tag
 func (_m *ChatLinkRepository) GetLinksByTag(ctx context.Context, uid int64, tag string) ([]*domain.Link, error) {
 	ret := _m.Called(ctx, uid, tag)
@@ -293,29 +414,29 @@ func (_c *ChatLinkRepository_GetLinksByTag_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
-// GetLinksPagination provides a mock function with given fields: ctx, offset, limit
-func (_m *ChatLinkRepository) GetLinksPagination(ctx context.Context, offset uint64, limit uint64) ([]*domain.Link, error) {
-	ret := _m.Called(ctx, offset, limit)
+// GetListLinks provides a mock function with given fields: ctx, uid
+func (_m *ChatLinkRepository) GetListLinks(ctx context.Context, uid int64) ([]*domain.Link, error) {
+	ret := _m.Called(ctx, uid)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLinksPagination")
+		panic("no return value specified for GetListLinks")
 	}
 
 	var r0 []*domain.Link
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) ([]*domain.Link, error)); ok {
-		return rf(ctx, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.Link, error)); ok {
+		return rf(ctx, uid)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []*domain.Link); ok {
-		r0 = rf(ctx, offset, limit)
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.Link); ok {
+		r0 = rf(ctx, uid)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Link)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
-		r1 = rf(ctx, offset, limit)
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, uid)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -323,59 +444,58 @@ func (_m *ChatLinkRepository) GetLinksPagination(ctx context.Context, offset uin
 	return r0, r1
 }
 
-// ChatLinkRepository_GetLinksPagination_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinksPagination'
-type ChatLinkRepository_GetLinksPagination_Call struct {
+// ChatLinkRepository_GetListLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListLinks'
+type ChatLinkRepository_GetListLinks_Call struct {
 	*mock.Call
 }
 
-// GetLinksPagination is a helper method to define mock.On call
+// GetListLinks is a helper method to define mock.On call
 //   - ctx context.Context
-//   - offset uint64
-//   - limit uint64
-func (_e *ChatLinkRepository_Expecter) GetLinksPagination(ctx interface{}, offset interface{}, limit interface{}) *ChatLinkRepository_GetLinksPagination_Call {
-	return &ChatLinkRepository_GetLinksPagination_Call{Call: _e.mock.On("GetLinksPagination", ctx, offset, limit)}
+//   - uid int64
+func (_e *ChatLinkRepository_Expecter) GetListLinks(ctx interface{}, uid interface{}) *ChatLinkRepository_GetListLinks_Call {
+	return &ChatLinkRepository_GetListLinks_Call{Call: _e.mock.On("GetListLinks", ctx, uid)}
 }
 
-func (_c *ChatLinkRepository_GetLinksPagination_Call) Run(run func(ctx context.Context, offset uint64, limit uint64)) *ChatLinkRepository_GetLinksPagination_Call {
+func (_c *ChatLinkRepository_GetListLinks_Call) Run(run func(ctx context.Context, uid int64)) *ChatLinkRepository_GetListLinks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uint64), args[2].(uint64))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *ChatLinkRepository_GetLinksPagination_Call) Return(_a0 []*domain.Link, _a1 error) *ChatLinkRepository_GetLinksPagination_Call {
+func (_c *ChatLinkRepository_GetListLinks_Call) Return(_a0 []*domain.Link, _a1 error) *ChatLinkRepository_GetListLinks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ChatLinkRepository_GetLinksPagination_Call) RunAndReturn(run func(context.Context, uint64, uint64) ([]*domain.Link, error)) *ChatLinkRepository_GetLinksPagination_Call {
+func (_c *ChatLinkRepository_GetListLinks_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.Link, error)) *ChatLinkRepository_GetListLinks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetListLinks provides a mock function with given fields: ctx, uid
-func (_m *ChatLinkRepository) GetListLinks(ctx context.Context, uid int64) ([]*domain.Link, error) {
-	ret := _m.Called(ctx, uid)
+// GetSubscribersByLink provides a mock function with given fields: ctx, link
+func (_m *ChatLinkRepository) GetSubscribersByLink(ctx context.Context, link *domain.Link) ([]*domain.Link, error) {
+	ret := _m.Called(ctx, link)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetListLinks")
+		panic("no return value specified for GetSubscribersByLink")
 	}
 
 	var r0 []*domain.Link
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]*domain.Link, error)); ok {
-		return rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) ([]*domain.Link, error)); ok {
+		return rf(ctx, link)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int64) []*domain.Link); ok {
-		r0 = rf(ctx, uid)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) []*domain.Link); ok {
+		r0 = rf(ctx, link)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*domain.Link)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
-		r1 = rf(ctx, uid)
+	if rf, ok := ret.Get(1).(func(context.Context, *domain.Link) error); ok {
+		r1 = rf(ctx, link)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -383,31 +503,80 @@ func (_m *ChatLinkRepository) GetListLinks(ctx context.Context, uid int64) ([]*d
 	return r0, r1
 }
 
-// ChatLinkRepository_GetListLinks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListLinks'
-type ChatLinkRepository_GetListLinks_Call struct {
+// ChatLinkRepository_GetSubscribersByLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSubscribersByLink'
+type ChatLinkRepository_GetSubscribersByLink_Call struct {
 	*mock.Call
 }
 
-// GetListLinks is a helper method to define mock.On call
+// GetSubscribersByLink is a helper method to define mock.On call
 //   - ctx context.Context
-//   - uid int64
-func (_e *ChatLinkRepository_Expecter) GetListLinks(ctx interface{}, uid interface{}) *ChatLinkRepository_GetListLinks_Call {
-	return &ChatLinkRepository_GetListLinks_Call{Call: _e.mock.On("GetListLinks", ctx, uid)}
+//   - link *domain.Link
+func (_e *ChatLinkRepository_Expecter) GetSubscribersByLink(ctx interface{}, link interface{}) *ChatLinkRepository_GetSubscribersByLink_Call {
+	return &ChatLinkRepository_GetSubscribersByLink_Call{Call: _e.mock.On("GetSubscribersByLink", ctx, link)}
 }
 
-func (_c *ChatLinkRepository_GetListLinks_Call) Run(run func(ctx context.Context, uid int64)) *ChatLinkRepository_GetListLinks_Call {
+func (_c *ChatLinkRepository_GetSubscribersByLink_Call) Run(run func(ctx context.Context, link *domain.Link)) *ChatLinkRepository_GetSubscribersByLink_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(int64))
+		run(args[0].(context.Context), args[1].(*domain.Link))
 	})
 	return _c
 }
 
-func (_c *ChatLinkRepository_GetListLinks_Call) Return(_a0 []*domain.Link, _a1 error) *ChatLinkRepository_GetListLinks_Call {
+func (_c *ChatLinkRepository_GetSubscribersByLink_Call) Return(_a0 []*domain.Link, _a1 error) *ChatLinkRepository_GetSubscribersByLink_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ChatLinkRepository_GetListLinks_Call) RunAndReturn(run func(context.Context, int64) ([]*domain.Link, error)) *ChatLinkRepository_GetListLinks_Call {
+func (_c *ChatLinkRepository_GetSubscribersByLink_Call) RunAndReturn(run func(context.Context, *domain.Link) ([]*domain.Link, error)) *ChatLinkRepository_GetSubscribersByLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MuteLink provides a mock function with given fields: ctx, uid, link, until
+func (_m *ChatLinkRepository) MuteLink(ctx context.Context, uid int64, link *domain.Link, until time.Time) error {
+	ret := _m.Called(ctx, uid, link, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.Link, time.Time) error); ok {
+		r0 = rf(ctx, uid, link, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChatLinkRepository_MuteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MuteLink'
+type ChatLinkRepository_MuteLink_Call struct {
+	*mock.Call
+}
+
+// MuteLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid int64
+//   - link *domain.Link
+//   - until time.Time
+func (_e *ChatLinkRepository_Expecter) MuteLink(ctx interface{}, uid interface{}, link interface{}, until interface{}) *ChatLinkRepository_MuteLink_Call {
+	return &ChatLinkRepository_MuteLink_Call{Call: _e.mock.On("MuteLink", ctx, uid, link, until)}
+}
+
+func (_c *ChatLinkRepository_MuteLink_Call) Run(run func(ctx context.Context, uid int64, link *domain.Link, until time.Time)) *ChatLinkRepository_MuteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.Link), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ChatLinkRepository_MuteLink_Call) Return(_a0 error) *ChatLinkRepository_MuteLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChatLinkRepository_MuteLink_Call) RunAndReturn(run func(context.Context, int64, *domain.Link, time.Time) error) *ChatLinkRepository_MuteLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -507,6 +676,55 @@ func (_c *ChatLinkRepository_SaveLink_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SetLinkUrgent provides a mock function with given fields: ctx, uid, link, urgent
+func (_m *ChatLinkRepository) SetLinkUrgent(ctx context.Context, uid int64, link *domain.Link, urgent bool) error {
+	ret := _m.Called(ctx, uid, link, urgent)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLinkUrgent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *domain.Link, bool) error); ok {
+		r0 = rf(ctx, uid, link, urgent)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChatLinkRepository_SetLinkUrgent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLinkUrgent'
+type ChatLinkRepository_SetLinkUrgent_Call struct {
+	*mock.Call
+}
+
+// SetLinkUrgent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uid int64
+//   - link *domain.Link
+//   - urgent bool
+func (_e *ChatLinkRepository_Expecter) SetLinkUrgent(ctx interface{}, uid interface{}, link interface{}, urgent interface{}) *ChatLinkRepository_SetLinkUrgent_Call {
+	return &ChatLinkRepository_SetLinkUrgent_Call{Call: _e.mock.On("SetLinkUrgent", ctx, uid, link, urgent)}
+}
+
+func (_c *ChatLinkRepository_SetLinkUrgent_Call) Run(run func(ctx context.Context, uid int64, link *domain.Link, urgent bool)) *ChatLinkRepository_SetLinkUrgent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*domain.Link), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *ChatLinkRepository_SetLinkUrgent_Call) Return(_a0 error) *ChatLinkRepository_SetLinkUrgent_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChatLinkRepository_SetLinkUrgent_Call) RunAndReturn(run func(context.Context, int64, *domain.Link, bool) error) *ChatLinkRepository_SetLinkUrgent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateLastCheck provides a mock function with given fields: ctx, link
 func (_m *ChatLinkRepository) UpdateLastCheck(ctx context.Context, link *domain.Link) error {
 	ret := _m.Called(ctx, link)
@@ -554,6 +772,100 @@ func (_c *ChatLinkRepository_UpdateLastCheck_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// UpdateLinkMetadata provides a mock function with given fields: ctx, link
+func (_m *ChatLinkRepository) UpdateLinkMetadata(ctx context.Context, link *domain.Link) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLinkMetadata")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChatLinkRepository_UpdateLinkMetadata_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLinkMetadata'
+type ChatLinkRepository_UpdateLinkMetadata_Call struct {
+	*mock.Call
+}
+
+// UpdateLinkMetadata is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.Link
+func (_e *ChatLinkRepository_Expecter) UpdateLinkMetadata(ctx interface{}, link interface{}) *ChatLinkRepository_UpdateLinkMetadata_Call {
+	return &ChatLinkRepository_UpdateLinkMetadata_Call{Call: _e.mock.On("UpdateLinkMetadata", ctx, link)}
+}
+
+func (_c *ChatLinkRepository_UpdateLinkMetadata_Call) Run(run func(ctx context.Context, link *domain.Link)) *ChatLinkRepository_UpdateLinkMetadata_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Link))
+	})
+	return _c
+}
+
+func (_c *ChatLinkRepository_UpdateLinkMetadata_Call) Return(_a0 error) *ChatLinkRepository_UpdateLinkMetadata_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChatLinkRepository_UpdateLinkMetadata_Call) RunAndReturn(run func(context.Context, *domain.Link) error) *ChatLinkRepository_UpdateLinkMetadata_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNextCheck provides a mock function with given fields: ctx, link
+func (_m *ChatLinkRepository) UpdateNextCheck(ctx context.Context, link *domain.Link) error {
+	ret := _m.Called(ctx, link)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNextCheck")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Link) error); ok {
+		r0 = rf(ctx, link)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChatLinkRepository_UpdateNextCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNextCheck'
+type ChatLinkRepository_UpdateNextCheck_Call struct {
+	*mock.Call
+}
+
+// UpdateNextCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - link *domain.Link
+func (_e *ChatLinkRepository_Expecter) UpdateNextCheck(ctx interface{}, link interface{}) *ChatLinkRepository_UpdateNextCheck_Call {
+	return &ChatLinkRepository_UpdateNextCheck_Call{Call: _e.mock.On("UpdateNextCheck", ctx, link)}
+}
+
+func (_c *ChatLinkRepository_UpdateNextCheck_Call) Run(run func(ctx context.Context, link *domain.Link)) *ChatLinkRepository_UpdateNextCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*domain.Link))
+	})
+	return _c
+}
+
+func (_c *ChatLinkRepository_UpdateNextCheck_Call) Return(_a0 error) *ChatLinkRepository_UpdateNextCheck_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChatLinkRepository_UpdateNextCheck_Call) RunAndReturn(run func(context.Context, *domain.Link) error) *ChatLinkRepository_UpdateNextCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewChatLinkRepository creates a new instance of ChatLinkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewChatLinkRepository(t interface {
@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+type OutboxStatus string
+
+const (
+	// OutboxStatusPending messages are delivered by the dispatcher once NextAttemptAt has passed.
+	OutboxStatusPending OutboxStatus = "pending"
+	// OutboxStatusDead messages ran out of attempts and wait for a manual replay.
+	OutboxStatusDead OutboxStatus = "dead"
+)
+
+// OutboxMessage is a link update stored in the same transaction as the link
+// check that produced it and delivered to the bot later.
+type OutboxMessage struct {
+	ID            int64
+	Update        bottypes.LinkUpdate
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+}
@@ -1,6 +1,11 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
 
 type RepositoryType string
 
@@ -17,10 +22,57 @@ type ChatLinkRepository interface {
 	// Link methods.
 	SaveLink(ctx context.Context, uid int64, link *Link) error
 	DeleteLink(ctx context.Context, uid int64, link *Link) error
+	// MuteLink stops sending the updates of the link to the chat until the time, zero unmutes it.
+	MuteLink(ctx context.Context, uid int64, link *Link, until time.Time) error
+	// SetLinkUrgent changes only the urgency of the link for the chat and makes the link due for a check,
+	// the last check of the chat is kept so no activity since then is skipped.
+	SetLinkUrgent(ctx context.Context, uid int64, link *Link, urgent bool) error
 	GetListLinks(ctx context.Context, uid int64) ([]*Link, error)
 	CheckUserExistence(ctx context.Context, uid int64) (bool, error)
 	GetChatIDsByLink(ctx context.Context, link *Link) ([]int64, error)
+	GetSubscribersByLink(ctx context.Context, link *Link) ([]*Link, error)
 	UpdateLastCheck(ctx context.Context, link *Link) error
+	UpdateLinkMetadata(ctx context.Context, link *Link) error
 	GetLinksByTag(ctx context.Context, uid int64, tag string) ([]*Link, error)
-	GetLinksPagination(ctx context.Context, offset, limit uint64) ([]*Link, error)
+	GetLinksAfter(ctx context.Context, cursor string, limit uint64) ([]*Link, error)
+
+	// Claim methods. A claimed link is hidden from other scrapper instances until
+	// its next check time, which is the end of the lease until it is rescheduled.
+	ClaimLinks(ctx context.Context, links []*Link, lease time.Duration) ([]*Link, error)
+	UpdateNextCheck(ctx context.Context, link *Link) error
+}
+
+// ActivityRepository remembers the revisions of the activities already notified for a link
+// and keeps the history of the detected activities.
+type ActivityRepository interface {
+	GetSeenRevisions(ctx context.Context, link *Link, sourceIDs []string) (map[string]string, error)
+	SaveSeenActivities(ctx context.Context, link *Link, activities []*Activity) error
+
+	// History methods.
+	AddActivities(ctx context.Context, link *Link, activities []*Activity) error
+	GetActivities(ctx context.Context, linkID int64, query ActivityQuery) ([]*Activity, error)
+}
+
+// DigestRepository stores the delivery settings of the chats and buffers the updates of the chats in digest mode.
+type DigestRepository interface {
+	// Settings methods. A chat without stored settings has the default ones.
+	GetChatSettings(ctx context.Context, uid int64) (*ChatSettings, error)
+	UpdateChatSettings(ctx context.Context, settings *ChatSettings) error
+	GetChatsSettings(ctx context.Context, uids []int64) (map[int64]*ChatSettings, error)
+
+	// Digest methods.
+	AddDigestEntry(ctx context.Context, uid int64, entry bottypes.DigestEntry) error
+	GetDueDigests(ctx context.Context, limit uint64) ([]*ChatSettings, error)
+	PopDigestEntries(ctx context.Context, uid int64) ([]bottypes.DigestEntry, error)
+	UpdateNextDigest(ctx context.Context, settings *ChatSettings) error
+}
+
+type OutboxRepository interface {
+	AddOutboxMessage(ctx context.Context, update bottypes.LinkUpdate) error
+	// ClaimOutboxMessages hides the returned due messages from other scrapper instances for the lease.
+	ClaimOutboxMessages(ctx context.Context, limit uint64, lease time.Duration) ([]*OutboxMessage, error)
+	UpdateOutboxMessage(ctx context.Context, message *OutboxMessage) error
+	DeleteOutboxMessage(ctx context.Context, id int64) error
+	GetDeadOutboxMessages(ctx context.Context, limit uint64) ([]*OutboxMessage, error)
+	ReplayOutboxMessage(ctx context.Context, id int64) error
 }
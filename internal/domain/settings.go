@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AFK068/bot/internal/domain/apperrors"
+)
+
+// LinkDigest is the type of the update grouping the buffered updates of a chat in digest mode.
+const LinkDigest ActivityType = "digest"
+
+type DigestMode string
+
+const (
+	// DigestImmediate chats get every update as soon as it is detected.
+	DigestImmediate DigestMode = "immediate"
+	// DigestHourly chats get the buffered updates at the start of every hour.
+	DigestHourly DigestMode = "hourly"
+	// DigestDaily chats get the buffered updates every day at the digest time.
+	DigestDaily DigestMode = "daily"
+	// DigestWeekly chats get the buffered updates every week on the digest weekday at the digest time.
+	DigestWeekly DigestMode = "weekly"
+)
+
+// DefaultTimezone is the timezone of a chat that has not set one.
+const DefaultTimezone = "UTC"
+
+// ChatSettings is the delivery preference of a chat. Times of day are in the timezone of the chat.
+type ChatSettings struct {
+	ChatID     int64
+	DigestMode DigestMode
+	// DigestTime is the time of day of daily and weekly digests since midnight.
+	DigestTime time.Duration
+	// DigestWeekday is the day of weekly digests.
+	DigestWeekday time.Weekday
+	// NextDigestAt is the time the buffered updates are sent at, zero if nothing is scheduled.
+	NextDigestAt time.Time
+
+	// Timezone is the IANA name of the timezone of the chat.
+	Timezone string
+	// QuietStart and QuietEnd are the times of day the quiet hours start and end at. The quiet
+	// hours may span midnight and are disabled when both are equal. Updates are held during them.
+	QuietStart time.Duration
+	QuietEnd   time.Duration
+}
+
+// DefaultChatSettings returns the settings of a chat that has not changed them.
+func DefaultChatSettings(chatID int64) *ChatSettings {
+	return &ChatSettings{
+		ChatID:        chatID,
+		DigestMode:    DigestImmediate,
+		DigestWeekday: time.Monday,
+		Timezone:      DefaultTimezone,
+	}
+}
+
+// Location returns the timezone of the chat.
+func (s *ChatSettings) Location() *time.Location {
+	return LoadLocation(s.Timezone)
+}
+
+// Quiet reports whether the chat has quiet hours.
+func (s *ChatSettings) Quiet() bool {
+	return s.QuietStart != s.QuietEnd
+}
+
+// QuietUntil returns the end of the quiet hours the time falls in, or zero outside of them.
+func (s *ChatSettings) QuietUntil(now time.Time) time.Time {
+	if !s.Quiet() {
+		return time.Time{}
+	}
+
+	local := now.In(s.Location())
+	sinceMidnight := time.Duration(local.Hour())*time.Hour + time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second
+
+	switch {
+	case s.QuietStart < s.QuietEnd && sinceMidnight >= s.QuietStart && sinceMidnight < s.QuietEnd:
+		return atTimeOfDay(local, 0, s.QuietEnd)
+	case s.QuietStart > s.QuietEnd && sinceMidnight >= s.QuietStart:
+		return atTimeOfDay(local, 1, s.QuietEnd)
+	case s.QuietStart > s.QuietEnd && sinceMidnight < s.QuietEnd:
+		return atTimeOfDay(local, 0, s.QuietEnd)
+	default:
+		return time.Time{}
+	}
+}
+
+// Digest reports whether the updates of the chat are buffered.
+func (s *ChatSettings) Digest() bool {
+	return s.DigestMode != DigestImmediate
+}
+
+// NextDigest returns the first digest time after now, or zero in the immediate mode.
+func (s *ChatSettings) NextDigest(now time.Time) time.Time {
+	local := now.In(s.Location())
+
+	switch s.DigestMode {
+	case DigestHourly:
+		return atTimeOfDay(local, 0, time.Duration(local.Hour()+1)*time.Hour)
+	case DigestDaily:
+		next := atTimeOfDay(local, 0, s.DigestTime)
+		if !next.After(now) {
+			next = atTimeOfDay(local, 1, s.DigestTime)
+		}
+
+		return next
+	case DigestWeekly:
+		days := (int(s.DigestWeekday) - int(local.Weekday()) + 7) % 7
+
+		next := atTimeOfDay(local, days, s.DigestTime)
+		if !next.After(now) {
+			next = atTimeOfDay(local, days+7, s.DigestTime)
+		}
+
+		return next
+	default:
+		return time.Time{}
+	}
+}
+
+// atTimeOfDay returns the time of day of the day the given number of days after the local time, in UTC.
+// The wall clock is kept across daylight saving time changes.
+func atTimeOfDay(local time.Time, days int, timeOfDay time.Duration) time.Time {
+	return time.Date(
+		local.Year(), local.Month(), local.Day()+days,
+		int(timeOfDay/time.Hour), int(timeOfDay%time.Hour/time.Minute), 0, 0,
+		local.Location(),
+	).UTC()
+}
+
+// ParseDigestMode validates the digest mode.
+func ParseDigestMode(raw string) (DigestMode, error) {
+	switch mode := DigestMode(strings.ToLower(strings.TrimSpace(raw))); mode {
+	case DigestImmediate, DigestHourly, DigestDaily, DigestWeekly:
+		return mode, nil
+	default:
+		return "", &apperrors.SettingsValidateError{Message: fmt.Sprintf("unknown digest mode %q", raw)}
+	}
+}
+
+// ParseDigestTime parses the time of day in the HH:MM format.
+func ParseDigestTime(raw string) (time.Duration, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(raw))
+	if err != nil {
+		return 0, &apperrors.SettingsValidateError{Message: fmt.Sprintf("invalid time %q, expected HH:MM", raw)}
+	}
+
+	return time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute, nil
+}
+
+// FormatDigestTime formats the time of day in the HH:MM format.
+func FormatDigestTime(digestTime time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(digestTime.Hours()), int(digestTime.Minutes())%60)
+}
+
+// ParseWeekday parses the English name of the day of the week, full or abbreviated to three letters.
+func ParseWeekday(raw string) (time.Weekday, error) {
+	value := strings.ToLower(strings.TrimSpace(raw))
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || (len(value) == 3 && strings.HasPrefix(name, value)) {
+			return day, nil
+		}
+	}
+
+	return 0, &apperrors.SettingsValidateError{Message: fmt.Sprintf("unknown weekday %q", raw)}
+}
+
+// ParseTimezone validates the IANA name of the timezone, such as Europe/Berlin.
+func ParseTimezone(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		return "", &apperrors.SettingsValidateError{Message: fmt.Sprintf("unknown timezone %q", raw)}
+	}
+
+	return name, nil
+}
+
+// LoadLocation returns the timezone with the IANA name, or UTC for unknown names.
+func LoadLocation(name string) *time.Location {
+	location, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return time.UTC
+	}
+
+	return location
+}
@@ -0,0 +1,156 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/domain"
+)
+
+func Test_ChatSettings_NextDigest(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		settings domain.ChatSettings
+		want     time.Time
+	}{
+		{
+			name:     "Immediate",
+			settings: domain.ChatSettings{DigestMode: domain.DigestImmediate},
+			want:     time.Time{},
+		},
+		{
+			name:     "Hourly",
+			settings: domain.ChatSettings{DigestMode: domain.DigestHourly},
+			want:     time.Date(2024, time.January, 3, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Daily later today",
+			settings: domain.ChatSettings{DigestMode: domain.DigestDaily, DigestTime: 18 * time.Hour},
+			want:     time.Date(2024, time.January, 3, 18, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Daily tomorrow",
+			settings: domain.ChatSettings{DigestMode: domain.DigestDaily, DigestTime: 10*time.Hour + 30*time.Minute},
+			want:     time.Date(2024, time.January, 4, 10, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "Weekly this week",
+			settings: domain.ChatSettings{DigestMode: domain.DigestWeekly, DigestWeekday: time.Friday, DigestTime: 9 * time.Hour},
+			want:     time.Date(2024, time.January, 5, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Weekly next week",
+			settings: domain.ChatSettings{DigestMode: domain.DigestWeekly, DigestWeekday: time.Wednesday, DigestTime: 9 * time.Hour},
+			want:     time.Date(2024, time.January, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Daily in timezone",
+			settings: domain.ChatSettings{DigestMode: domain.DigestDaily, DigestTime: 9 * time.Hour, Timezone: "Asia/Tokyo"},
+			want:     time.Date(2024, time.January, 4, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Hourly in timezone with half hour offset",
+			settings: domain.ChatSettings{DigestMode: domain.DigestHourly, Timezone: "Asia/Kolkata"},
+			want:     time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC).Add(time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.settings.NextDigest(now))
+		})
+	}
+}
+
+func Test_ChatSettings_QuietUntil(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings domain.ChatSettings
+		now      time.Time
+		want     time.Time
+	}{
+		{
+			name:     "Disabled",
+			settings: domain.ChatSettings{},
+			now:      time.Date(2024, time.January, 3, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Within the day",
+			settings: domain.ChatSettings{QuietStart: 13 * time.Hour, QuietEnd: 14 * time.Hour},
+			now:      time.Date(2024, time.January, 3, 13, 30, 0, 0, time.UTC),
+			want:     time.Date(2024, time.January, 3, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Before midnight",
+			settings: domain.ChatSettings{QuietStart: 22 * time.Hour, QuietEnd: 7 * time.Hour},
+			now:      time.Date(2024, time.January, 3, 23, 0, 0, 0, time.UTC),
+			want:     time.Date(2024, time.January, 4, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "After midnight",
+			settings: domain.ChatSettings{QuietStart: 22 * time.Hour, QuietEnd: 7 * time.Hour},
+			now:      time.Date(2024, time.January, 3, 3, 0, 0, 0, time.UTC),
+			want:     time.Date(2024, time.January, 3, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "Outside",
+			settings: domain.ChatSettings{QuietStart: 22 * time.Hour, QuietEnd: 7 * time.Hour},
+			now:      time.Date(2024, time.January, 3, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "In timezone",
+			settings: domain.ChatSettings{QuietStart: 22 * time.Hour, QuietEnd: 7 * time.Hour, Timezone: "Europe/Berlin"},
+			now:      time.Date(2024, time.January, 3, 22, 0, 0, 0, time.UTC),
+			want:     time.Date(2024, time.January, 4, 6, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.settings.QuietUntil(tt.now))
+		})
+	}
+}
+
+func Test_ParseDigestSettings(t *testing.T) {
+	mode, err := domain.ParseDigestMode(" Daily")
+	require.NoError(t, err)
+	assert.Equal(t, domain.DigestDaily, mode)
+
+	_, err = domain.ParseDigestMode("monthly")
+	assert.Error(t, err)
+
+	digestTime, err := domain.ParseDigestTime("09:05")
+	require.NoError(t, err)
+	assert.Equal(t, 9*time.Hour+5*time.Minute, digestTime)
+	assert.Equal(t, "09:05", domain.FormatDigestTime(digestTime))
+
+	_, err = domain.ParseDigestTime("25:00")
+	assert.Error(t, err)
+
+	weekday, err := domain.ParseWeekday("fri")
+	require.NoError(t, err)
+	assert.Equal(t, time.Friday, weekday)
+
+	_, err = domain.ParseWeekday("someday")
+	assert.Error(t, err)
+}
+
+func Test_ParseTimezone(t *testing.T) {
+	timezone, err := domain.ParseTimezone(" Europe/Berlin ")
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", timezone)
+
+	for _, raw := range []string{"", "Local", "Mars/Olympus"} {
+		_, err = domain.ParseTimezone(raw)
+		assert.Error(t, err, raw)
+	}
+
+	assert.Equal(t, time.UTC, domain.LoadLocation("Mars/Olympus"))
+}
@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+)
+
+type TransportType string
+
+const (
+	// HTTPTransport calls the other service over its OpenAPI endpoints.
+	HTTPTransport TransportType = "http"
+	// KafkaTransport publishes link updates to a topic consumed by the bot.
+	KafkaTransport TransportType = "kafka"
+	// GRPCTransport calls the other service over gRPC, streaming link updates to the bot.
+	GRPCTransport TransportType = "grpc"
+)
+
+// ParseTransport checks the configured transport against the supported ones. An empty value
+// selects HTTPTransport, unknown values are rejected so a typo does not silently fall back to HTTP.
+func ParseTransport(value TransportType, supported ...TransportType) (TransportType, error) {
+	if value == "" {
+		return HTTPTransport, nil
+	}
+
+	if !slices.Contains(supported, value) {
+		return "", fmt.Errorf("unknown transport %q, expected one of %v", value, supported)
+	}
+
+	return value, nil
+}
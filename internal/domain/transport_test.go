@@ -0,0 +1,28 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/domain"
+)
+
+func Test_ParseTransport(t *testing.T) {
+	supported := []domain.TransportType{domain.HTTPTransport, domain.KafkaTransport}
+
+	transport, err := domain.ParseTransport("", supported...)
+	require.NoError(t, err)
+	assert.Equal(t, domain.HTTPTransport, transport)
+
+	transport, err = domain.ParseTransport(domain.KafkaTransport, supported...)
+	require.NoError(t, err)
+	assert.Equal(t, domain.KafkaTransport, transport)
+
+	_, err = domain.ParseTransport("kafak", supported...)
+	assert.ErrorContains(t, err, `unknown transport "kafak"`)
+
+	_, err = domain.ParseTransport(domain.GRPCTransport, supported...)
+	assert.Error(t, err)
+}
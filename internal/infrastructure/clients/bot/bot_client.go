@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/go-resty/resty/v2"
 	"github.com/labstack/echo/v4"
 
@@ -18,6 +19,16 @@ type Service interface {
 	PostUpdates(ctx context.Context, update bottypes.LinkUpdate) error
 }
 
+// InvalidUpdateError is returned when the bot rejects the update as invalid,
+// sending it again cannot succeed.
+type InvalidUpdateError struct {
+	Description string
+}
+
+func (e *InvalidUpdateError) Error() string {
+	return fmt.Sprintf("bad request: %s", e.Description)
+}
+
 type Client struct {
 	BaseURL string
 	Client  *resty.Client
@@ -58,12 +69,12 @@ func (c *Client) PostUpdates(ctx context.Context, update bottypes.LinkUpdate) er
 		var apiErr bottypes.ApiErrorResponse
 		if err := json.Unmarshal(resp.Body(), &apiErr); err != nil {
 			c.Logger.Error("Failed to decode error response: ", "error", err)
-			return fmt.Errorf("failed to decode error response: %w", err)
+			return &InvalidUpdateError{Description: http.StatusText(http.StatusBadRequest)}
 		}
 
-		c.Logger.Error("Bad request: ", "description", *apiErr.Description)
+		c.Logger.Error("Bad request: ", "description", aws.StringValue(apiErr.Description))
 
-		return fmt.Errorf("bad request: %s", *apiErr.Description)
+		return &InvalidUpdateError{Description: aws.StringValue(apiErr.Description)}
 	default:
 		c.Logger.Error("Unexpected status code: ", "status_code", resp.StatusCode())
 		return fmt.Errorf("unexpected status code: %d", resp.StatusCode())
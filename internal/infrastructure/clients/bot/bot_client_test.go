@@ -3,12 +3,14 @@ package bot_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/AFK068/bot/internal/infrastructure/clients/bot"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
@@ -47,3 +49,37 @@ func Test_PostUpdates(t *testing.T) {
 	err := client.PostUpdates(context.Background(), reqBody)
 	assert.NoError(t, err)
 }
+
+func Test_PostUpdates_BadRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		err := json.NewEncoder(w).Encode(bottypes.ApiErrorResponse{Description: aws.String("Link is empty")})
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := bot.NewClient(server.URL, logger.NewDiscardLogger())
+	err := client.PostUpdates(context.Background(), bottypes.LinkUpdate{TgChatIds: &[]int64{1}})
+
+	var invalidErr *bot.InvalidUpdateError
+	require.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, "Link is empty", invalidErr.Description)
+}
+
+func Test_PostUpdates_ServerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	defer server.Close()
+
+	client := bot.NewClient(server.URL, logger.NewDiscardLogger())
+	err := client.PostUpdates(context.Background(), bottypes.LinkUpdate{TgChatIds: &[]int64{1}})
+	require.Error(t, err)
+
+	var invalidErr *bot.InvalidUpdateError
+	assert.False(t, errors.As(err, &invalidErr))
+}
@@ -0,0 +1,151 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	botgrpc "github.com/AFK068/bot/internal/api/grpc/bot/v1"
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+// GRPCClient delivers updates to the bot over one long-lived stream.
+// The stream is opened on the first update and reopened after a failure.
+type GRPCClient struct {
+	conn     *grpc.ClientConn
+	client   botgrpc.BotServiceClient
+	stream   botgrpc.BotService_StreamUpdatesClient
+	cancel   context.CancelFunc
+	sequence uint64
+	mu       sync.Mutex
+	Logger   *logger.Logger
+}
+
+func NewGRPCClient(addr string, log *logger.Logger) (*GRPCClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("creating grpc client: %w", err)
+	}
+
+	return &GRPCClient{
+		conn:   conn,
+		client: botgrpc.NewBotServiceClient(conn),
+		Logger: log,
+	}, nil
+}
+
+// PostUpdates sends the update and waits for its acknowledgement. Updates are
+// sent one at a time, so the acknowledgement always belongs to the last update.
+func (c *GRPCClient) PostUpdates(ctx context.Context, update bottypes.LinkUpdate) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stream == nil {
+		if err := c.openStream(); err != nil {
+			return err
+		}
+	}
+
+	c.sequence++
+
+	resp, err := c.roundTrip(ctx, &botgrpc.StreamUpdatesRequest{
+		Sequence: c.sequence,
+		Update:   mapper.MapLinkUpdateToProto(update),
+	})
+	if err != nil {
+		c.Logger.Error("Failed to stream update", "error", err)
+		c.closeStream()
+
+		return fmt.Errorf("failed to stream update: %w", err)
+	}
+
+	if resp.GetSequence() != c.sequence {
+		c.closeStream()
+
+		return fmt.Errorf("unexpected acknowledgement: got sequence %d, want %d", resp.GetSequence(), c.sequence)
+	}
+
+	if resp.GetError() != "" {
+		c.Logger.Error("Update rejected", "error", resp.GetError())
+
+		if resp.GetInvalid() {
+			return &InvalidUpdateError{Description: resp.GetError()}
+		}
+
+		return fmt.Errorf("update rejected: %s", resp.GetError())
+	}
+
+	c.Logger.Info("Update posted successfully")
+
+	return nil
+}
+
+func (c *GRPCClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closeStream()
+
+	return c.conn.Close()
+}
+
+func (c *GRPCClient) openStream() error {
+	// The stream outlives a single update, so it does not use the context of the call.
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream, err := c.client.StreamUpdates(ctx)
+	if err != nil {
+		cancel()
+		return fmt.Errorf("opening updates stream: %w", err)
+	}
+
+	c.stream = stream
+	c.cancel = cancel
+
+	return nil
+}
+
+func (c *GRPCClient) closeStream() {
+	if c.stream == nil {
+		return
+	}
+
+	c.cancel()
+	c.stream = nil
+	c.cancel = nil
+}
+
+// roundTrip sends the request and waits for the response until the context is done.
+func (c *GRPCClient) roundTrip(ctx context.Context, req *botgrpc.StreamUpdatesRequest) (*botgrpc.StreamUpdatesResponse, error) {
+	type result struct {
+		resp *botgrpc.StreamUpdatesResponse
+		err  error
+	}
+
+	stream := c.stream
+	done := make(chan result, 1)
+
+	go func() {
+		if err := stream.Send(req); err != nil {
+			done <- result{err: err}
+			return
+		}
+
+		resp, err := stream.Recv()
+		done <- result{resp: resp, err: err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, errors.Join(ctx.Err(), errors.New("update was not acknowledged"))
+	case res := <-done:
+		return res.resp, res.err
+	}
+}
@@ -0,0 +1,120 @@
+package bot_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/AFK068/bot/internal/infrastructure/clients/bot"
+	"github.com/AFK068/bot/internal/infrastructure/grpcapi/botapi"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	botgrpc "github.com/AFK068/bot/internal/api/grpc/bot/v1"
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	telegramapi "github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
+)
+
+func setupGRPC(t *testing.T, handler botapi.Handler) *bot.GRPCClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	botgrpc.RegisterBotServiceServer(server, botapi.NewBotServer(handler, logger.NewDiscardLogger()))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	client, err := bot.NewGRPCClient(listener.Addr().String(), logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, client.Close())
+		server.Stop()
+	})
+
+	return client
+}
+
+func Test_GRPC_PostUpdates(t *testing.T) {
+	updateType := bottypes.LinkUpdateType("github_issue")
+	digestType := bottypes.LinkUpdateType("digest")
+
+	updates := []bottypes.LinkUpdate{
+		{
+			Id:          aws.Int64(1),
+			Url:         aws.String("https://github.com/test/test"),
+			Description: aws.String("description"),
+			UserName:    aws.String("user"),
+			Type:        &updateType,
+			TgChatIds:   &[]int64{1, 2},
+			СreatedAt:   aws.Time(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			Url:       aws.String("https://stackoverflow.com/questions/1"),
+			TgChatIds: &[]int64{3},
+		},
+		{
+			Type:      &digestType,
+			TgChatIds: &[]int64{4},
+			Digest: &[]bottypes.DigestEntry{
+				{
+					Url:         aws.String("https://github.com/test/test"),
+					Tags:        &[]string{"work"},
+					Description: aws.String("description"),
+					UserName:    aws.String("user"),
+					Type:        &updateType,
+					CreatedAt:   aws.Time(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+				},
+				{
+					Url: aws.String("https://stackoverflow.com/questions/1"),
+				},
+			},
+		},
+	}
+
+	var received []bottypes.LinkUpdate
+
+	client := setupGRPC(t, func(_ context.Context, update bottypes.LinkUpdate) error {
+		received = append(received, update)
+		return nil
+	})
+
+	// All updates are sent over the same stream.
+	for _, update := range updates {
+		require.NoError(t, client.PostUpdates(context.Background(), update))
+	}
+
+	assert.Equal(t, updates, received)
+}
+
+func Test_GRPC_PostUpdates_Rejected(t *testing.T) {
+	client := setupGRPC(t, func(context.Context, bottypes.LinkUpdate) error {
+		return assert.AnError
+	})
+
+	err := client.PostUpdates(context.Background(), bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+	assert.ErrorContains(t, err, assert.AnError.Error())
+
+	// A rejected update keeps the stream usable.
+	err = client.PostUpdates(context.Background(), bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+	assert.ErrorContains(t, err, assert.AnError.Error())
+}
+
+func Test_GRPC_PostUpdates_Invalid(t *testing.T) {
+	client := setupGRPC(t, func(context.Context, bottypes.LinkUpdate) error {
+		return &telegramapi.InvalidUpdateError{Code: telegramapi.ErrLinkIsEmpty, Description: telegramapi.ErrLinkIsEmptyDescription}
+	})
+
+	err := client.PostUpdates(context.Background(), bottypes.LinkUpdate{TgChatIds: &[]int64{1}})
+
+	var invalidErr *bot.InvalidUpdateError
+	require.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, telegramapi.ErrLinkIsEmptyDescription, invalidErr.Description)
+}
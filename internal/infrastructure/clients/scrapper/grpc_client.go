@@ -0,0 +1,283 @@
+package scrapper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/pkg/utils"
+
+	scrappergrpc "github.com/AFK068/bot/internal/api/grpc/scrapper/v1"
+	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+)
+
+// GRPCClient talks to the scrapper over gRPC. Errors are converted to the
+// responses the HTTP client returns, so callers handle both the same way.
+type GRPCClient struct {
+	conn   *grpc.ClientConn
+	client scrappergrpc.ScrapperServiceClient
+	Logger *logger.Logger
+}
+
+func NewGRPCClient(addr string, log *logger.Logger) (*GRPCClient, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("creating grpc client: %w", err)
+	}
+
+	return &GRPCClient{
+		conn:   conn,
+		client: scrappergrpc.NewScrapperServiceClient(conn),
+		Logger: log,
+	}, nil
+}
+
+func (c *GRPCClient) PostTgChatID(ctx context.Context, id int64) error {
+	c.Logger.Info("Posting TgChatID", "id", id)
+
+	_, err := c.client.RegisterChat(ctx, &scrappergrpc.RegisterChatRequest{Id: id})
+
+	return c.handleError(err)
+}
+
+func (c *GRPCClient) DeleteTgChatID(ctx context.Context, id int64) error {
+	c.Logger.Info("Deleting TgChatID", "id", id)
+
+	_, err := c.client.DeleteChat(ctx, &scrappergrpc.DeleteChatRequest{Id: id})
+
+	return c.handleError(err)
+}
+
+func (c *GRPCClient) PostLinks(ctx context.Context, tgChatID int64, link scrappertypes.AddLinkRequest) error {
+	c.Logger.Info("Posting Links", "tgChatID", tgChatID, "link", link)
+
+	req := &scrappergrpc.AddLinkRequest{
+		TgChatId: tgChatID,
+		Link:     aws.StringValue(link.Link),
+		Urgent:   aws.BoolValue(link.Urgent),
+	}
+
+	if link.Tags != nil {
+		req.Tags = *link.Tags
+	}
+
+	if link.Filters != nil {
+		req.Filters = *link.Filters
+	}
+
+	if link.CheckInterval != nil {
+		req.CheckInterval = *link.CheckInterval
+	}
+
+	_, err := c.client.AddLink(ctx, req)
+
+	return c.handleError(err)
+}
+
+func (c *GRPCClient) DeleteLinks(ctx context.Context, tgChatID int64, link scrappertypes.RemoveLinkRequest) error {
+	c.Logger.Info("Deleting Links", "tgChatID", tgChatID, "link", link)
+
+	_, err := c.client.RemoveLink(ctx, &scrappergrpc.RemoveLinkRequest{
+		TgChatId: tgChatID,
+		Link:     aws.StringValue(link.Link),
+	})
+
+	return c.handleError(err)
+}
+
+func (c *GRPCClient) MuteLink(ctx context.Context, tgChatID int64, req scrappertypes.MuteLinkRequest) error {
+	c.Logger.Info("Muting Link", "tgChatID", tgChatID, "link", req.Link)
+
+	_, err := c.client.MuteLink(ctx, &scrappergrpc.MuteLinkRequest{
+		TgChatId: tgChatID,
+		Link:     aws.StringValue(req.Link),
+		Duration: aws.Int64Value(req.Duration),
+	})
+
+	return c.handleError(err)
+}
+
+func (c *GRPCClient) UrgentLink(ctx context.Context, tgChatID int64, req scrappertypes.UrgentLinkRequest) error {
+	c.Logger.Info("Changing Link urgency", "tgChatID", tgChatID, "link", req.Link)
+
+	_, err := c.client.UrgentLink(ctx, &scrappergrpc.UrgentLinkRequest{
+		TgChatId: tgChatID,
+		Link:     aws.StringValue(req.Link),
+		Urgent:   aws.BoolValue(req.Urgent),
+	})
+
+	return c.handleError(err)
+}
+
+func (c *GRPCClient) GetLinks(ctx context.Context, tgChatID int64, tag ...string) (scrappertypes.ListLinksResponse, error) {
+	c.Logger.Info("Getting Links", "tgChatID", tgChatID)
+
+	req := &scrappergrpc.ListLinksRequest{TgChatId: tgChatID}
+	if len(tag) > 0 {
+		req.Tag = tag[0]
+	}
+
+	resp, err := c.client.ListLinks(ctx, req)
+	if err != nil {
+		return scrappertypes.ListLinksResponse{}, c.handleError(err)
+	}
+
+	links := make([]scrappertypes.LinkResponse, len(resp.GetLinks()))
+	for i, link := range resp.GetLinks() {
+		links[i] = scrappertypes.LinkResponse{
+			Id:      aws.Int64(link.GetId()),
+			Url:     aws.String(link.GetUrl()),
+			Tags:    utils.SliceStringPtr(link.GetTags()),
+			Filters: utils.SliceStringPtr(link.GetFilters()),
+		}
+
+		if link.GetCheckInterval() > 0 {
+			links[i].CheckInterval = aws.Int64(link.GetCheckInterval())
+		}
+
+		if link.GetBroken() {
+			links[i].Broken = aws.Bool(true)
+		}
+
+		if link.GetUrgent() {
+			links[i].Urgent = aws.Bool(true)
+		}
+	}
+
+	return scrappertypes.ListLinksResponse{
+		Links: &links,
+		Size:  aws.Int32(resp.GetSize()),
+	}, nil
+}
+
+func (c *GRPCClient) GetLinkActivities(
+	ctx context.Context,
+	linkID int64,
+	params scrappertypes.GetLinksIdActivitiesParams,
+) (scrappertypes.ListActivitiesResponse, error) {
+	c.Logger.Info("Getting link activities", "tgChatID", params.TgChatId, "linkID", linkID)
+
+	req := &scrappergrpc.ListLinkActivitiesRequest{
+		TgChatId: params.TgChatId,
+		LinkId:   linkID,
+		Type:     aws.StringValue(params.Type),
+		Limit:    aws.Int64Value(params.Limit),
+	}
+
+	if params.Since != nil {
+		req.Since = timestamppb.New(*params.Since)
+	}
+
+	resp, err := c.client.ListLinkActivities(ctx, req)
+	if err != nil {
+		return scrappertypes.ListActivitiesResponse{}, c.handleError(err)
+	}
+
+	activities := make([]scrappertypes.ActivityResponse, len(resp.GetActivities()))
+	for i, activity := range resp.GetActivities() {
+		activities[i] = scrappertypes.ActivityResponse{
+			Type:        aws.String(activity.GetType()),
+			Title:       aws.String(activity.GetTitle()),
+			Description: aws.String(activity.GetDescription()),
+			UserName:    aws.String(activity.GetUserName()),
+			Labels:      utils.SliceStringPtr(activity.GetLabels()),
+			CreatedAt:   aws.Time(activity.GetCreatedAt().AsTime()),
+		}
+	}
+
+	return scrappertypes.ListActivitiesResponse{
+		Activities: &activities,
+		Size:       aws.Int32(resp.GetSize()),
+	}, nil
+}
+
+func (c *GRPCClient) GetChatSettings(ctx context.Context, tgChatID int64) (scrappertypes.ChatSettings, error) {
+	c.Logger.Info("Getting chat settings", "tgChatID", tgChatID)
+
+	resp, err := c.client.GetChatSettings(ctx, &scrappergrpc.GetChatSettingsRequest{TgChatId: tgChatID})
+	if err != nil {
+		return scrappertypes.ChatSettings{}, c.handleError(err)
+	}
+
+	return mapChatSettings(resp), nil
+}
+
+func (c *GRPCClient) PutChatSettings(
+	ctx context.Context,
+	tgChatID int64,
+	settings scrappertypes.ChatSettings,
+) (scrappertypes.ChatSettings, error) {
+	c.Logger.Info("Putting chat settings", "tgChatID", tgChatID)
+
+	resp, err := c.client.UpdateChatSettings(ctx, &scrappergrpc.UpdateChatSettingsRequest{
+		TgChatId: tgChatID,
+		Settings: &scrappergrpc.ChatSettings{
+			DigestMode:    aws.StringValue(settings.DigestMode),
+			DigestTime:    aws.StringValue(settings.DigestTime),
+			DigestWeekday: aws.StringValue(settings.DigestWeekday),
+			Timezone:      aws.StringValue(settings.Timezone),
+			QuietStart:    aws.StringValue(settings.QuietStart),
+			QuietEnd:      aws.StringValue(settings.QuietEnd),
+		},
+	})
+	if err != nil {
+		return scrappertypes.ChatSettings{}, c.handleError(err)
+	}
+
+	return mapChatSettings(resp), nil
+}
+
+func (c *GRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+func (c *GRPCClient) handleError(err error) error {
+	if err == nil {
+		c.Logger.Info("Request successful")
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		c.Logger.Error("Failed to do request", "error", err)
+		return fmt.Errorf("failed to do request: %w", err)
+	}
+
+	code := http.StatusInternalServerError
+
+	switch st.Code() {
+	case codes.InvalidArgument, codes.AlreadyExists:
+		code = http.StatusBadRequest
+	case codes.NotFound:
+		code = http.StatusNotFound
+	case codes.Unauthenticated:
+		code = http.StatusUnauthorized
+	}
+
+	c.Logger.Warn("API error response", "code", st.Code().String(), "description", st.Message())
+
+	return &apperrors.ErrorResponse{
+		Code:    code,
+		Message: st.Message(),
+	}
+}
+
+func mapChatSettings(settings *scrappergrpc.ChatSettings) scrappertypes.ChatSettings {
+	return scrappertypes.ChatSettings{
+		DigestMode:    aws.String(settings.GetDigestMode()),
+		DigestTime:    aws.String(settings.GetDigestTime()),
+		DigestWeekday: aws.String(settings.GetDigestWeekday()),
+		Timezone:      aws.String(settings.GetTimezone()),
+		QuietStart:    aws.String(settings.GetQuietStart()),
+		QuietEnd:      aws.String(settings.GetQuietEnd()),
+	}
+}
@@ -0,0 +1,285 @@
+package scrapper_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/AFK068/bot/internal/application/provider"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/internal/infrastructure/clients/scrapper"
+	"github.com/AFK068/bot/internal/infrastructure/grpcapi/scrapperapi"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	scrappergrpc "github.com/AFK068/bot/internal/api/grpc/scrapper/v1"
+	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+	repomock "github.com/AFK068/bot/internal/domain/mocks"
+	transactormock "github.com/AFK068/bot/internal/infrastructure/grpcapi/scrapperapi/mocks"
+)
+
+// newRegistry returns the built-in providers, their clients are not used by the handlers.
+func newRegistry(t *testing.T) *provider.Registry {
+	registry, err := provider.NewRegistry(
+		provider.NewGitHubProvider(nil, logger.NewDiscardLogger()),
+		provider.NewStackOverflowProvider(nil, logger.NewDiscardLogger()),
+	)
+	require.NoError(t, err)
+
+	return registry
+}
+
+func setupGRPC(
+	t *testing.T,
+	repo domain.ChatLinkRepository,
+	activities domain.ActivityRepository,
+	digests domain.DigestRepository,
+	transactor scrapperapi.Transactor,
+) *scrapper.GRPCClient {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	scrappergrpc.RegisterScrapperServiceServer(
+		server,
+		scrapperapi.NewScrapperServer(transactor, repo, activities, digests, newRegistry(t), logger.NewDiscardLogger()),
+	)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	client, err := scrapper.NewGRPCClient(listener.Addr().String(), logger.NewDiscardLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, client.Close())
+		server.Stop()
+	})
+
+	return client
+}
+
+func Test_GRPC_PostTgChatID(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
+	repoMock.On("RegisterChat", mock.Anything, int64(123)).Return(nil)
+
+	err := client.PostTgChatID(context.Background(), 123)
+	assert.NoError(t, err)
+}
+
+func Test_GRPC_PostLinks(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	transactorMock := transactormock.NewTransactor(t)
+	client := setupGRPC(t, repoMock, nil, nil, transactorMock)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("SaveLink", mock.Anything, int64(123), mock.MatchedBy(func(link *domain.Link) bool {
+		return link.URL == "https://github.com/test/test" && link.Type == domain.GithubType &&
+			assert.ObjectsAreEqual([]string{"tag"}, link.Tags)
+	})).Return(nil)
+
+	transactorMock.On("WithTransaction", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(ctx context.Context) error)
+			assert.NoError(t, fn(context.Background()))
+		}).
+		Return(nil)
+
+	err := client.PostLinks(context.Background(), 123, scrappertypes.AddLinkRequest{
+		Link:          aws.String("https://github.com/test/test"),
+		Tags:          &[]string{"tag"},
+		CheckInterval: aws.Int64(600),
+	})
+	assert.NoError(t, err)
+}
+
+func Test_GRPC_GetLinks(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("GetLinksByTag", mock.Anything, int64(123), "tag").Return([]*domain.Link{
+		{ID: 7, URL: "https://github.com/test/test", Tags: []string{"tag"}, MaxCheckInterval: 10 * time.Minute, Broken: true},
+	}, nil)
+
+	resp, err := client.GetLinks(context.Background(), 123, "tag")
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), *resp.Size)
+	assert.Equal(t, int64(7), *(*resp.Links)[0].Id)
+	assert.Equal(t, "https://github.com/test/test", *(*resp.Links)[0].Url)
+	assert.Equal(t, []string{"tag"}, *(*resp.Links)[0].Tags)
+	assert.Equal(t, []string{}, *(*resp.Links)[0].Filters)
+	assert.Equal(t, int64(600), *(*resp.Links)[0].CheckInterval)
+	assert.True(t, *(*resp.Links)[0].Broken)
+}
+
+func Test_GRPC_GetLinkActivities(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	activitiesMock := repomock.NewActivityRepository(t)
+	client := setupGRPC(t, repoMock, activitiesMock, nil, nil)
+
+	since := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return([]*domain.Link{{ID: 7, URL: "https://github.com/test/test"}}, nil)
+	activitiesMock.On("GetActivities", mock.Anything, int64(7), domain.ActivityQuery{
+		Since: since,
+		Types: []domain.ActivityType{domain.GitHubIssue},
+		Limit: 3,
+	}).Return([]*domain.Activity{
+		{Type: domain.GitHubIssue, Title: "Crash", Body: "Crash on start", Labels: []string{"bug"}, CreatedAt: since.Add(time.Hour)},
+	}, nil)
+
+	resp, err := client.GetLinkActivities(context.Background(), 7, scrappertypes.GetLinksIdActivitiesParams{
+		TgChatId: 123,
+		Since:    aws.Time(since),
+		Type:     aws.String("issue"),
+		Limit:    aws.Int64(3),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), *resp.Size)
+	assert.Equal(t, string(domain.GitHubIssue), *(*resp.Activities)[0].Type)
+	assert.Equal(t, "Crash on start", *(*resp.Activities)[0].Description)
+	assert.Equal(t, []string{"bug"}, *(*resp.Activities)[0].Labels)
+	assert.Equal(t, since.Add(time.Hour), *(*resp.Activities)[0].CreatedAt)
+}
+
+func Test_GRPC_GetLinkActivities_NotTracked(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return([]*domain.Link{}, nil)
+
+	_, err := client.GetLinkActivities(context.Background(), 7, scrappertypes.GetLinksIdActivitiesParams{TgChatId: 123})
+
+	var errResp *apperrors.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusNotFound, errResp.Code)
+}
+
+func Test_GRPC_PutChatSettings(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	digestsMock := repomock.NewDigestRepository(t)
+	client := setupGRPC(t, repoMock, nil, digestsMock, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	digestsMock.On("UpdateChatSettings", mock.Anything, mock.MatchedBy(func(settings *domain.ChatSettings) bool {
+		return settings.ChatID == 123 && settings.DigestMode == domain.DigestDaily && settings.DigestTime == 9*time.Hour &&
+			settings.Timezone == "Europe/Berlin" && settings.QuietStart == 23*time.Hour && settings.QuietEnd == 7*time.Hour
+	})).Return(nil)
+
+	resp, err := client.PutChatSettings(context.Background(), 123, scrappertypes.ChatSettings{
+		DigestMode: aws.String("daily"),
+		DigestTime: aws.String("09:00"),
+		Timezone:   aws.String("Europe/Berlin"),
+		QuietStart: aws.String("23:00"),
+		QuietEnd:   aws.String("07:00"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "daily", *resp.DigestMode)
+	assert.Equal(t, "09:00", *resp.DigestTime)
+	assert.Equal(t, "Europe/Berlin", *resp.Timezone)
+	assert.Equal(t, "23:00", *resp.QuietStart)
+	assert.Equal(t, "07:00", *resp.QuietEnd)
+}
+
+func Test_GRPC_PutChatSettings_Invalid(t *testing.T) {
+	client := setupGRPC(t, nil, nil, nil, nil)
+
+	_, err := client.PutChatSettings(context.Background(), 123, scrappertypes.ChatSettings{DigestMode: aws.String("monthly")})
+
+	var errResp *apperrors.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusBadRequest, errResp.Code)
+}
+
+func Test_GRPC_GetChatSettings_NotFound(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
+
+	_, err := client.GetChatSettings(context.Background(), 123)
+
+	var errResp *apperrors.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusNotFound, errResp.Code)
+}
+
+func Test_GRPC_DeleteLinks_NotFound(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("DeleteLink", mock.Anything, int64(123), mock.Anything).
+		Return(&apperrors.LinkIsNotExistError{Message: "link is not exist"})
+
+	err := client.DeleteLinks(context.Background(), 123, scrappertypes.RemoveLinkRequest{
+		Link: aws.String("https://github.com/test/test"),
+	})
+
+	var errResp *apperrors.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusNotFound, errResp.Code)
+	assert.Equal(t, scrapperapi.ErrDescriptionLinkNotExist, errResp.Message)
+}
+
+func Test_GRPC_MuteLink(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("MuteLink", mock.Anything, int64(123), &domain.Link{URL: "https://github.com/test/test"},
+		mock.MatchedBy(func(until time.Time) bool {
+			return until.After(time.Now().Add(23*time.Hour)) && until.Before(time.Now().Add(25*time.Hour))
+		})).Return(nil)
+
+	err := client.MuteLink(context.Background(), 123, scrappertypes.MuteLinkRequest{
+		Link:     aws.String("https://github.com/test/test"),
+		Duration: aws.Int64(int64(24 * time.Hour / time.Second)),
+	})
+	require.NoError(t, err)
+}
+
+func Test_GRPC_UrgentLink(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	repoMock.On("SetLinkUrgent", mock.Anything, int64(123), &domain.Link{URL: "https://github.com/test/test"}, true).Return(nil)
+
+	err := client.UrgentLink(context.Background(), 123, scrappertypes.UrgentLinkRequest{
+		Link:   aws.String("https://github.com/test/test"),
+		Urgent: aws.Bool(true),
+	})
+	require.NoError(t, err)
+}
+
+func Test_GRPC_GetLinks_Unauthorized(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	client := setupGRPC(t, repoMock, nil, nil, nil)
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
+
+	_, err := client.GetLinks(context.Background(), 123)
+
+	var errResp *apperrors.ErrorResponse
+	require.ErrorAs(t, err, &errResp)
+	assert.Equal(t, http.StatusUnauthorized, errResp.Code)
+}
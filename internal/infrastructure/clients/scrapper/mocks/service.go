@@ -5,9 +5,8 @@ package mocks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	v1 "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -118,6 +117,121 @@ func (_c *Service_DeleteTgChatID_Call) RunAndReturn(run func(context.Context, in
 	return _c
 }
 
+// GetChatSettings provides a mock function with given fields: ctx, tgChatID
+func (_m *Service) GetChatSettings(ctx context.Context, tgChatID int64) (v1.ChatSettings, error) {
+	ret := _m.Called(ctx, tgChatID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetChatSettings")
+	}
+
+	var r0 v1.ChatSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (v1.ChatSettings, error)); ok {
+		return rf(ctx, tgChatID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) v1.ChatSettings); ok {
+		r0 = rf(ctx, tgChatID)
+	} else {
+		r0 = ret.Get(0).(v1.ChatSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, tgChatID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_GetChatSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetChatSettings'
+type Service_GetChatSettings_Call struct {
+	*mock.Call
+}
+
+// GetChatSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgChatID int64
+func (_e *Service_Expecter) GetChatSettings(ctx interface{}, tgChatID interface{}) *Service_GetChatSettings_Call {
+	return &Service_GetChatSettings_Call{Call: _e.mock.On("GetChatSettings", ctx, tgChatID)}
+}
+
+func (_c *Service_GetChatSettings_Call) Run(run func(ctx context.Context, tgChatID int64)) *Service_GetChatSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *Service_GetChatSettings_Call) Return(_a0 v1.ChatSettings, _a1 error) *Service_GetChatSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_GetChatSettings_Call) RunAndReturn(run func(context.Context, int64) (v1.ChatSettings, error)) *Service_GetChatSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLinkActivities provides a mock function with given fields: ctx, linkID, params
+func (_m *Service) GetLinkActivities(ctx context.Context, linkID int64, params v1.GetLinksIdActivitiesParams) (v1.ListActivitiesResponse, error) {
+	ret := _m.Called(ctx, linkID, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLinkActivities")
+	}
+
+	var r0 v1.ListActivitiesResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, v1.GetLinksIdActivitiesParams) (v1.ListActivitiesResponse, error)); ok {
+		return rf(ctx, linkID, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, v1.GetLinksIdActivitiesParams) v1.ListActivitiesResponse); ok {
+		r0 = rf(ctx, linkID, params)
+	} else {
+		r0 = ret.Get(0).(v1.ListActivitiesResponse)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, v1.GetLinksIdActivitiesParams) error); ok {
+		r1 = rf(ctx, linkID, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_GetLinkActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLinkActivities'
+type Service_GetLinkActivities_Call struct {
+	*mock.Call
+}
+
+// GetLinkActivities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - linkID int64
+//   - params v1.GetLinksIdActivitiesParams
+func (_e *Service_Expecter) GetLinkActivities(ctx interface{}, linkID interface{}, params interface{}) *Service_GetLinkActivities_Call {
+	return &Service_GetLinkActivities_Call{Call: _e.mock.On("GetLinkActivities", ctx, linkID, params)}
+}
+
+func (_c *Service_GetLinkActivities_Call) Run(run func(ctx context.Context, linkID int64, params v1.GetLinksIdActivitiesParams)) *Service_GetLinkActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(v1.GetLinksIdActivitiesParams))
+	})
+	return _c
+}
+
+func (_c *Service_GetLinkActivities_Call) Return(_a0 v1.ListActivitiesResponse, _a1 error) *Service_GetLinkActivities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_GetLinkActivities_Call) RunAndReturn(run func(context.Context, int64, v1.GetLinksIdActivitiesParams) (v1.ListActivitiesResponse, error)) *Service_GetLinkActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetLinks provides a mock function with given fields: ctx, tgChatID, tag
 func (_m *Service) GetLinks(ctx context.Context, tgChatID int64, tag ...string) (v1.ListLinksResponse, error) {
 	_va := make([]interface{}, len(tag))
@@ -190,6 +304,54 @@ func (_c *Service_GetLinks_Call) RunAndReturn(run func(context.Context, int64, .
 	return _c
 }
 
+// MuteLink provides a mock function with given fields: ctx, tgChatID, req
+func (_m *Service) MuteLink(ctx context.Context, tgChatID int64, req v1.MuteLinkRequest) error {
+	ret := _m.Called(ctx, tgChatID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MuteLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, v1.MuteLinkRequest) error); ok {
+		r0 = rf(ctx, tgChatID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Service_MuteLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MuteLink'
+type Service_MuteLink_Call struct {
+	*mock.Call
+}
+
+// MuteLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgChatID int64
+//   - req v1.MuteLinkRequest
+func (_e *Service_Expecter) MuteLink(ctx interface{}, tgChatID interface{}, req interface{}) *Service_MuteLink_Call {
+	return &Service_MuteLink_Call{Call: _e.mock.On("MuteLink", ctx, tgChatID, req)}
+}
+
+func (_c *Service_MuteLink_Call) Run(run func(ctx context.Context, tgChatID int64, req v1.MuteLinkRequest)) *Service_MuteLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(v1.MuteLinkRequest))
+	})
+	return _c
+}
+
+func (_c *Service_MuteLink_Call) Return(_a0 error) *Service_MuteLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Service_MuteLink_Call) RunAndReturn(run func(context.Context, int64, v1.MuteLinkRequest) error) *Service_MuteLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PostLinks provides a mock function with given fields: ctx, tgChatID, link
 func (_m *Service) PostLinks(ctx context.Context, tgChatID int64, link v1.AddLinkRequest) error {
 	ret := _m.Called(ctx, tgChatID, link)
@@ -285,6 +447,112 @@ func (_c *Service_PostTgChatID_Call) RunAndReturn(run func(context.Context, int6
 	return _c
 }
 
+// PutChatSettings provides a mock function with given fields: ctx, tgChatID, settings
+func (_m *Service) PutChatSettings(ctx context.Context, tgChatID int64, settings v1.ChatSettings) (v1.ChatSettings, error) {
+	ret := _m.Called(ctx, tgChatID, settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutChatSettings")
+	}
+
+	var r0 v1.ChatSettings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, v1.ChatSettings) (v1.ChatSettings, error)); ok {
+		return rf(ctx, tgChatID, settings)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, v1.ChatSettings) v1.ChatSettings); ok {
+		r0 = rf(ctx, tgChatID, settings)
+	} else {
+		r0 = ret.Get(0).(v1.ChatSettings)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, v1.ChatSettings) error); ok {
+		r1 = rf(ctx, tgChatID, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_PutChatSettings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutChatSettings'
+type Service_PutChatSettings_Call struct {
+	*mock.Call
+}
+
+// PutChatSettings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgChatID int64
+//   - settings v1.ChatSettings
+func (_e *Service_Expecter) PutChatSettings(ctx interface{}, tgChatID interface{}, settings interface{}) *Service_PutChatSettings_Call {
+	return &Service_PutChatSettings_Call{Call: _e.mock.On("PutChatSettings", ctx, tgChatID, settings)}
+}
+
+func (_c *Service_PutChatSettings_Call) Run(run func(ctx context.Context, tgChatID int64, settings v1.ChatSettings)) *Service_PutChatSettings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(v1.ChatSettings))
+	})
+	return _c
+}
+
+func (_c *Service_PutChatSettings_Call) Return(_a0 v1.ChatSettings, _a1 error) *Service_PutChatSettings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_PutChatSettings_Call) RunAndReturn(run func(context.Context, int64, v1.ChatSettings) (v1.ChatSettings, error)) *Service_PutChatSettings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UrgentLink provides a mock function with given fields: ctx, tgChatID, req
+func (_m *Service) UrgentLink(ctx context.Context, tgChatID int64, req v1.UrgentLinkRequest) error {
+	ret := _m.Called(ctx, tgChatID, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UrgentLink")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, v1.UrgentLinkRequest) error); ok {
+		r0 = rf(ctx, tgChatID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Service_UrgentLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UrgentLink'
+type Service_UrgentLink_Call struct {
+	*mock.Call
+}
+
+// UrgentLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tgChatID int64
+//   - req v1.UrgentLinkRequest
+func (_e *Service_Expecter) UrgentLink(ctx interface{}, tgChatID interface{}, req interface{}) *Service_UrgentLink_Call {
+	return &Service_UrgentLink_Call{Call: _e.mock.On("UrgentLink", ctx, tgChatID, req)}
+}
+
+func (_c *Service_UrgentLink_Call) Run(run func(ctx context.Context, tgChatID int64, req v1.UrgentLinkRequest)) *Service_UrgentLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(v1.UrgentLinkRequest))
+	})
+	return _c
+}
+
+func (_c *Service_UrgentLink_Call) Return(_a0 error) *Service_UrgentLink_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Service_UrgentLink_Call) RunAndReturn(run func(context.Context, int64, v1.UrgentLinkRequest) error) *Service_UrgentLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewService creates a new instance of Service. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewService(t interface {
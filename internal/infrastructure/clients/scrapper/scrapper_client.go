@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/labstack/echo/v4"
@@ -18,7 +20,16 @@ type Service interface {
 	DeleteTgChatID(ctx context.Context, id int64) error
 	PostLinks(ctx context.Context, tgChatID int64, link scrappertypes.AddLinkRequest) error
 	DeleteLinks(ctx context.Context, tgChatID int64, link scrappertypes.RemoveLinkRequest) error
+	MuteLink(ctx context.Context, tgChatID int64, req scrappertypes.MuteLinkRequest) error
+	UrgentLink(ctx context.Context, tgChatID int64, req scrappertypes.UrgentLinkRequest) error
 	GetLinks(ctx context.Context, tgChatID int64, tag ...string) (scrappertypes.ListLinksResponse, error)
+	GetLinkActivities(
+		ctx context.Context,
+		linkID int64,
+		params scrappertypes.GetLinksIdActivitiesParams,
+	) (scrappertypes.ListActivitiesResponse, error)
+	GetChatSettings(ctx context.Context, tgChatID int64) (scrappertypes.ChatSettings, error)
+	PutChatSettings(ctx context.Context, tgChatID int64, settings scrappertypes.ChatSettings) (scrappertypes.ChatSettings, error)
 }
 
 type Client struct {
@@ -107,6 +118,44 @@ func (c *Client) DeleteLinks(ctx context.Context, tgChatID int64, link scrappert
 	return c.handleResponse(resp.StatusCode(), resp.Body())
 }
 
+func (c *Client) MuteLink(ctx context.Context, tgChatID int64, req scrappertypes.MuteLinkRequest) error {
+	url := fmt.Sprintf("%s/links/mute", c.BaseURL)
+	c.Logger.Info("Muting Link", "url", url, "tgChatID", tgChatID, "link", req.Link)
+
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader(echo.HeaderContentType, echo.MIMEApplicationJSON).
+		SetHeader(echo.HeaderAccept, echo.MIMEApplicationJSON).
+		SetHeader("Tg-Chat-Id", fmt.Sprintf("%d", tgChatID)).
+		SetBody(req).
+		Post(url)
+	if err != nil {
+		c.Logger.Error("Failed to mute Link", "error", err)
+		return fmt.Errorf("failed to do request: %w", err)
+	}
+
+	return c.handleResponse(resp.StatusCode(), resp.Body())
+}
+
+func (c *Client) UrgentLink(ctx context.Context, tgChatID int64, req scrappertypes.UrgentLinkRequest) error {
+	url := fmt.Sprintf("%s/links/urgent", c.BaseURL)
+	c.Logger.Info("Changing Link urgency", "url", url, "tgChatID", tgChatID, "link", req.Link)
+
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader(echo.HeaderContentType, echo.MIMEApplicationJSON).
+		SetHeader(echo.HeaderAccept, echo.MIMEApplicationJSON).
+		SetHeader("Tg-Chat-Id", fmt.Sprintf("%d", tgChatID)).
+		SetBody(req).
+		Post(url)
+	if err != nil {
+		c.Logger.Error("Failed to change Link urgency", "error", err)
+		return fmt.Errorf("failed to do request: %w", err)
+	}
+
+	return c.handleResponse(resp.StatusCode(), resp.Body())
+}
+
 func (c *Client) GetLinks(ctx context.Context, tgChatID int64, tag ...string) (scrappertypes.ListLinksResponse, error) {
 	url := fmt.Sprintf("%s/links", c.BaseURL)
 	c.Logger.Info("Getting Links", "url", url, "tgChatID", tgChatID)
@@ -138,3 +187,99 @@ func (c *Client) GetLinks(ctx context.Context, tgChatID int64, tag ...string) (s
 
 	return links, nil
 }
+
+func (c *Client) GetLinkActivities(
+	ctx context.Context,
+	linkID int64,
+	params scrappertypes.GetLinksIdActivitiesParams,
+) (scrappertypes.ListActivitiesResponse, error) {
+	url := fmt.Sprintf("%s/links/%d/activities", c.BaseURL, linkID)
+	c.Logger.Info("Getting link activities", "url", url, "tgChatID", params.TgChatId)
+
+	req := c.Client.R().
+		SetContext(ctx).
+		SetHeader(echo.HeaderContentType, echo.MIMEApplicationJSON).
+		SetHeader(echo.HeaderAccept, echo.MIMEApplicationJSON).
+		SetHeader("Tg-Chat-Id", fmt.Sprintf("%d", params.TgChatId))
+
+	if params.Since != nil {
+		req.SetQueryParam("since", params.Since.Format(time.RFC3339))
+	}
+
+	if params.Type != nil && *params.Type != "" {
+		req.SetQueryParam("type", *params.Type)
+	}
+
+	if params.Limit != nil {
+		req.SetQueryParam("limit", strconv.FormatInt(*params.Limit, 10))
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		c.Logger.Error("Failed to get link activities", "error", err)
+		return scrappertypes.ListActivitiesResponse{}, fmt.Errorf("failed to do request: %w", err)
+	}
+
+	if err := c.handleResponse(resp.StatusCode(), resp.Body()); err != nil {
+		return scrappertypes.ListActivitiesResponse{}, err
+	}
+
+	var activities scrappertypes.ListActivitiesResponse
+	if err := json.Unmarshal(resp.Body(), &activities); err != nil {
+		return scrappertypes.ListActivitiesResponse{}, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return activities, nil
+}
+
+func (c *Client) GetChatSettings(ctx context.Context, tgChatID int64) (scrappertypes.ChatSettings, error) {
+	url := fmt.Sprintf("%s/tg-chat/%d/settings", c.BaseURL, tgChatID)
+	c.Logger.Info("Getting chat settings", "url", url, "tgChatID", tgChatID)
+
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader(echo.HeaderContentType, echo.MIMEApplicationJSON).
+		SetHeader(echo.HeaderAccept, echo.MIMEApplicationJSON).
+		Get(url)
+	if err != nil {
+		c.Logger.Error("Failed to get chat settings", "error", err)
+		return scrappertypes.ChatSettings{}, fmt.Errorf("failed to do request: %w", err)
+	}
+
+	return c.decodeChatSettings(resp.StatusCode(), resp.Body())
+}
+
+func (c *Client) PutChatSettings(
+	ctx context.Context,
+	tgChatID int64,
+	settings scrappertypes.ChatSettings,
+) (scrappertypes.ChatSettings, error) {
+	url := fmt.Sprintf("%s/tg-chat/%d/settings", c.BaseURL, tgChatID)
+	c.Logger.Info("Putting chat settings", "url", url, "tgChatID", tgChatID)
+
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader(echo.HeaderContentType, echo.MIMEApplicationJSON).
+		SetHeader(echo.HeaderAccept, echo.MIMEApplicationJSON).
+		SetBody(settings).
+		Put(url)
+	if err != nil {
+		c.Logger.Error("Failed to put chat settings", "error", err)
+		return scrappertypes.ChatSettings{}, fmt.Errorf("failed to do request: %w", err)
+	}
+
+	return c.decodeChatSettings(resp.StatusCode(), resp.Body())
+}
+
+func (c *Client) decodeChatSettings(code int, body []byte) (scrappertypes.ChatSettings, error) {
+	if err := c.handleResponse(code, body); err != nil {
+		return scrappertypes.ChatSettings{}, err
+	}
+
+	var settings scrappertypes.ChatSettings
+	if err := json.Unmarshal(body, &settings); err != nil {
+		return scrappertypes.ChatSettings{}, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	return settings, nil
+}
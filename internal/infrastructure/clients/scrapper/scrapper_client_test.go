@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
@@ -118,6 +119,64 @@ func Test_DeleteLinks(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func Test_MuteLink(t *testing.T) {
+	reqBody := scrappertypes.MuteLinkRequest{
+		Link:     aws.String("https://example.com"),
+		Duration: aws.Int64(86400),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		assert.Equal(t, "/links/mute", r.URL.Path)
+
+		assert.Equal(t, r.Header.Get("Tg-Chat-ID"), "123")
+
+		var body scrappertypes.MuteLinkRequest
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, reqBody, body)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	client := scrapper.NewClient(server.URL, logger.NewDiscardLogger())
+	err := client.MuteLink(context.Background(), 123, reqBody)
+	assert.NoError(t, err)
+}
+
+func Test_UrgentLink(t *testing.T) {
+	reqBody := scrappertypes.UrgentLinkRequest{
+		Link:   aws.String("https://example.com"),
+		Urgent: aws.Bool(true),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		assert.Equal(t, "/links/urgent", r.URL.Path)
+
+		assert.Equal(t, r.Header.Get("Tg-Chat-ID"), "123")
+
+		var body scrappertypes.UrgentLinkRequest
+		err := json.NewDecoder(r.Body).Decode(&body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, reqBody, body)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	defer server.Close()
+
+	client := scrapper.NewClient(server.URL, logger.NewDiscardLogger())
+	err := client.UrgentLink(context.Background(), 123, reqBody)
+	assert.NoError(t, err)
+}
+
 func Test_GetLinks(t *testing.T) {
 	response := scrappertypes.ListLinksResponse{
 		Links: &[]scrappertypes.LinkResponse{
@@ -194,3 +253,79 @@ func Test_WithTag_GetLinks(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, response, resp)
 }
+
+func Test_GetLinkActivities(t *testing.T) {
+	since := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	response := scrappertypes.ListActivitiesResponse{
+		Activities: &[]scrappertypes.ActivityResponse{
+			{
+				Type:        aws.String("github_issue"),
+				Description: aws.String("Crash on start"),
+				CreatedAt:   aws.Time(since.Add(time.Hour)),
+			},
+		},
+		Size: aws.Int32(1),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+
+		assert.Equal(t, "/links/7/activities", r.URL.Path)
+
+		assert.Equal(t, r.Header.Get("Tg-Chat-ID"), "123")
+		assert.Equal(t, r.URL.Query().Get("since"), "2023-01-01T00:00:00Z")
+		assert.Equal(t, r.URL.Query().Get("type"), "issue")
+		assert.Equal(t, r.URL.Query().Get("limit"), "5")
+
+		resp, err := json.Marshal(response)
+		assert.NoError(t, err)
+
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(resp)
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := scrapper.NewClient(server.URL, logger.NewDiscardLogger())
+	resp, err := client.GetLinkActivities(context.Background(), 7, scrappertypes.GetLinksIdActivitiesParams{
+		TgChatId: 123,
+		Since:    aws.Time(since),
+		Type:     aws.String("issue"),
+		Limit:    aws.Int64(5),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, response, resp)
+}
+
+func Test_PutChatSettings(t *testing.T) {
+	settings := scrappertypes.ChatSettings{
+		DigestMode:    aws.String("weekly"),
+		DigestTime:    aws.String("18:00"),
+		DigestWeekday: aws.String("friday"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/tg-chat/123/settings", r.URL.Path)
+
+		var body scrappertypes.ChatSettings
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, settings, body)
+
+		resp, err := json.Marshal(body)
+		assert.NoError(t, err)
+
+		w.WriteHeader(http.StatusOK)
+		_, err = w.Write(resp)
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := scrapper.NewClient(server.URL, logger.NewDiscardLogger())
+	resp, err := client.PutChatSettings(context.Background(), 123, settings)
+	assert.NoError(t, err)
+	assert.Equal(t, settings, resp)
+}
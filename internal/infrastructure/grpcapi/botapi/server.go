@@ -0,0 +1,61 @@
+package botapi
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	botgrpc "github.com/AFK068/bot/internal/api/grpc/bot/v1"
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	telegramapi "github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
+)
+
+// Handler processes one link update received from the scrapper.
+type Handler func(ctx context.Context, update bottypes.LinkUpdate) error
+
+// BotServer receives link updates from the scrapper over gRPC streams.
+type BotServer struct {
+	handler Handler
+	Logger  *logger.Logger
+}
+
+func NewBotServer(handler Handler, log *logger.Logger) *BotServer {
+	return &BotServer{
+		handler: handler,
+		Logger:  log,
+	}
+}
+
+// StreamUpdates handles updates in the order they arrive and acknowledges each of them.
+// A failed update is reported in its acknowledgement and does not close the stream,
+// updates rejected as invalid are marked so the scrapper does not send them again.
+func (s *BotServer) StreamUpdates(stream botgrpc.BotService_StreamUpdatesServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		resp := &botgrpc.StreamUpdatesResponse{Sequence: req.GetSequence()}
+
+		if err := s.handler(stream.Context(), mapper.MapProtoToLinkUpdate(req.GetUpdate())); err != nil {
+			s.Logger.Warn("Failed to handle update", "sequence", req.GetSequence(), "error", err)
+
+			var invalidErr *telegramapi.InvalidUpdateError
+
+			resp.Error = err.Error()
+			resp.Invalid = errors.As(err, &invalidErr)
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
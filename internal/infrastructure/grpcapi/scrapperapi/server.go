@@ -0,0 +1,432 @@
+package scrapperapi
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/application/provider"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	scrappergrpc "github.com/AFK068/bot/internal/api/grpc/scrapper/v1"
+	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+)
+
+const (
+	ErrDescriptionChatAlreadyExist     = "Chat already exists"
+	ErrDescriptionChatNotExist         = "Chat not found"
+	ErrDescriptionInternalError        = "Internal error"
+	ErrDescriptionInvalidBody          = "Invalid request body"
+	ErrDescriptionLinkNotExist         = "Link not exist"
+	ErrDescriptionLinkTypeNotSupported = "Link type not supported"
+)
+
+type Transactor interface {
+	WithTransaction(ctx context.Context, txFunc func(ctx context.Context) error) error
+}
+
+// ScrapperServer serves the chat and link methods of the scrapper over gRPC.
+// Errors are reported with the status codes matching the HTTP API responses.
+type ScrapperServer struct {
+	transactor Transactor
+	repository domain.ChatLinkRepository
+	activities domain.ActivityRepository
+	digests    domain.DigestRepository
+	providers  *provider.Registry
+	Logger     *logger.Logger
+}
+
+func NewScrapperServer(
+	transactor Transactor,
+	repo domain.ChatLinkRepository,
+	activities domain.ActivityRepository,
+	digests domain.DigestRepository,
+	providers *provider.Registry,
+	log *logger.Logger,
+) *ScrapperServer {
+	return &ScrapperServer{
+		transactor: transactor,
+		repository: repo,
+		activities: activities,
+		digests:    digests,
+		providers:  providers,
+		Logger:     log,
+	}
+}
+
+func (s *ScrapperServer) RegisterChat(
+	ctx context.Context,
+	req *scrappergrpc.RegisterChatRequest,
+) (*scrappergrpc.RegisterChatResponse, error) {
+	s.Logger.Info("Registering chat", "ID", req.GetId())
+
+	exist, err := s.repository.CheckUserExistence(ctx, req.GetId())
+	if err != nil {
+		s.Logger.Error("Failed to check user existence", "ID", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	if exist {
+		s.Logger.Warn("Chat already exists", "ID", req.GetId())
+		return nil, status.Error(codes.AlreadyExists, ErrDescriptionChatAlreadyExist)
+	}
+
+	if err := s.repository.RegisterChat(ctx, req.GetId()); err != nil {
+		s.Logger.Error("Failed to register chat", "ID", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return &scrappergrpc.RegisterChatResponse{}, nil
+}
+
+func (s *ScrapperServer) DeleteChat(
+	ctx context.Context,
+	req *scrappergrpc.DeleteChatRequest,
+) (*scrappergrpc.DeleteChatResponse, error) {
+	s.Logger.Info("Removing chat", "ID", req.GetId())
+
+	exist, err := s.repository.CheckUserExistence(ctx, req.GetId())
+	if err != nil {
+		s.Logger.Error("Failed to check user existence", "ID", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	if !exist {
+		s.Logger.Warn("Chat does not exist", "ID", req.GetId())
+		return nil, status.Error(codes.NotFound, ErrDescriptionChatNotExist)
+	}
+
+	if err := s.repository.DeleteChat(ctx, req.GetId()); err != nil {
+		s.Logger.Error("Failed to remove chat", "ID", req.GetId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return &scrappergrpc.DeleteChatResponse{}, nil
+}
+
+func (s *ScrapperServer) ListLinks(
+	ctx context.Context,
+	req *scrappergrpc.ListLinksRequest,
+) (*scrappergrpc.ListLinksResponse, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.Unauthenticated); err != nil {
+		return nil, err
+	}
+
+	links, err := func() ([]*domain.Link, error) {
+		if req.GetTag() != "" {
+			return s.repository.GetLinksByTag(ctx, req.GetTgChatId(), req.GetTag())
+		}
+
+		return s.repository.GetListLinks(ctx, req.GetTgChatId())
+	}()
+	if err != nil {
+		s.Logger.Error("Failed to get links for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	resp := &scrappergrpc.ListLinksResponse{
+		Links: make([]*scrappergrpc.Link, len(links)),
+		Size:  int32(len(links)), //nolint:gosec // as per the requirements
+	}
+
+	for i, link := range links {
+		resp.Links[i] = &scrappergrpc.Link{
+			Id:            link.ID,
+			Url:           link.URL,
+			Tags:          link.Tags,
+			Filters:       link.Filters,
+			CheckInterval: int64(link.MaxCheckInterval / time.Second),
+			Broken:        link.Broken,
+			Urgent:        link.Urgent,
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *ScrapperServer) AddLink(ctx context.Context, req *scrappergrpc.AddLinkRequest) (*scrappergrpc.AddLinkResponse, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.Unauthenticated); err != nil {
+		return nil, err
+	}
+
+	link, err := mapper.MapAddLinkRequestToDomain(s.providers, req.GetTgChatId(), &scrappertypes.AddLinkRequest{
+		Link:          &req.Link,
+		Tags:          &req.Tags,
+		Filters:       &req.Filters,
+		CheckInterval: &req.CheckInterval,
+		Urgent:        &req.Urgent,
+	})
+
+	var linkValidateErr *apperrors.LinkValidateError
+	if errors.As(err, &linkValidateErr) {
+		s.Logger.Warn("Link validation error", "error", err)
+		return nil, status.Error(codes.InvalidArgument, ErrDescriptionInvalidBody)
+	}
+
+	var linkTypeErr *apperrors.LinkTypeError
+	if errors.As(err, &linkTypeErr) {
+		s.Logger.Warn("Link type not supported", "error", err)
+		return nil, status.Error(codes.InvalidArgument, ErrDescriptionLinkTypeNotSupported)
+	}
+
+	var filterValidateErr *apperrors.FilterValidateError
+	if errors.As(err, &filterValidateErr) {
+		s.Logger.Warn("Filter validation error", "error", err)
+		return nil, status.Error(codes.InvalidArgument, filterValidateErr.Message)
+	}
+
+	if err != nil {
+		s.Logger.Error("Internal error", "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	err = s.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		return s.repository.SaveLink(ctx, req.GetTgChatId(), link)
+	})
+	if err != nil {
+		s.Logger.Error("Failed to save link for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return &scrappergrpc.AddLinkResponse{}, nil
+}
+
+func (s *ScrapperServer) RemoveLink(
+	ctx context.Context,
+	req *scrappergrpc.RemoveLinkRequest,
+) (*scrappergrpc.RemoveLinkResponse, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.Unauthenticated); err != nil {
+		return nil, err
+	}
+
+	if req.GetLink() == "" {
+		s.Logger.Warn("Link is empty")
+		return nil, status.Error(codes.InvalidArgument, ErrDescriptionInvalidBody)
+	}
+
+	err := s.repository.DeleteLink(ctx, req.GetTgChatId(), &domain.Link{URL: req.GetLink()})
+
+	var linkNotExistErr *apperrors.LinkIsNotExistError
+	if errors.As(err, &linkNotExistErr) {
+		s.Logger.Warn("Link does not exist", "error", err)
+		return nil, status.Error(codes.NotFound, ErrDescriptionLinkNotExist)
+	}
+
+	if err != nil {
+		s.Logger.Error("Failed to remove link for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return &scrappergrpc.RemoveLinkResponse{}, nil
+}
+
+func (s *ScrapperServer) MuteLink(ctx context.Context, req *scrappergrpc.MuteLinkRequest) (*scrappergrpc.MuteLinkResponse, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.Unauthenticated); err != nil {
+		return nil, err
+	}
+
+	if req.GetLink() == "" || req.GetDuration() < 0 {
+		s.Logger.Warn("Invalid mute request")
+		return nil, status.Error(codes.InvalidArgument, ErrDescriptionInvalidBody)
+	}
+
+	var until time.Time
+	if req.GetDuration() > 0 {
+		until = time.Now().Add(time.Duration(req.GetDuration()) * time.Second)
+	}
+
+	err := s.repository.MuteLink(ctx, req.GetTgChatId(), &domain.Link{URL: req.GetLink()}, until)
+
+	var linkNotExistErr *apperrors.LinkIsNotExistError
+	if errors.As(err, &linkNotExistErr) {
+		s.Logger.Warn("Link does not exist", "error", err)
+		return nil, status.Error(codes.NotFound, ErrDescriptionLinkNotExist)
+	}
+
+	if err != nil {
+		s.Logger.Error("Failed to mute link for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return &scrappergrpc.MuteLinkResponse{}, nil
+}
+
+func (s *ScrapperServer) UrgentLink(ctx context.Context, req *scrappergrpc.UrgentLinkRequest) (*scrappergrpc.UrgentLinkResponse, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.Unauthenticated); err != nil {
+		return nil, err
+	}
+
+	if req.GetLink() == "" {
+		s.Logger.Warn("Invalid urgent request")
+		return nil, status.Error(codes.InvalidArgument, ErrDescriptionInvalidBody)
+	}
+
+	err := s.repository.SetLinkUrgent(ctx, req.GetTgChatId(), &domain.Link{URL: req.GetLink()}, req.GetUrgent())
+
+	var linkNotExistErr *apperrors.LinkIsNotExistError
+	if errors.As(err, &linkNotExistErr) {
+		s.Logger.Warn("Link does not exist", "error", err)
+		return nil, status.Error(codes.NotFound, ErrDescriptionLinkNotExist)
+	}
+
+	if err != nil {
+		s.Logger.Error("Failed to change link urgency for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return &scrappergrpc.UrgentLinkResponse{}, nil
+}
+
+func (s *ScrapperServer) ListLinkActivities(
+	ctx context.Context,
+	req *scrappergrpc.ListLinkActivitiesRequest,
+) (*scrappergrpc.ListLinkActivitiesResponse, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.Unauthenticated); err != nil {
+		return nil, err
+	}
+
+	var since *time.Time
+	if req.GetSince() != nil {
+		since = aws.Time(req.GetSince().AsTime())
+	}
+
+	var limit *int64
+	if req.GetLimit() != 0 {
+		limit = aws.Int64(req.GetLimit())
+	}
+
+	query, err := mapper.MapActivityQueryToDomain(s.providers, since, aws.String(req.GetType()), limit)
+
+	var queryValidateErr *apperrors.ActivityQueryValidateError
+	if errors.As(err, &queryValidateErr) {
+		s.Logger.Warn("Activity query validation error", "error", err)
+		return nil, status.Error(codes.InvalidArgument, queryValidateErr.Message)
+	}
+
+	links, err := s.repository.GetListLinks(ctx, req.GetTgChatId())
+	if err != nil {
+		s.Logger.Error("Failed to get links for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	if !slices.ContainsFunc(links, func(link *domain.Link) bool { return link.ID == req.GetLinkId() }) {
+		s.Logger.Warn("Link is not tracked by chat", "ID", req.GetTgChatId(), "link", req.GetLinkId())
+		return nil, status.Error(codes.NotFound, ErrDescriptionLinkNotExist)
+	}
+
+	activities, err := s.activities.GetActivities(ctx, req.GetLinkId(), query)
+	if err != nil {
+		s.Logger.Error("Failed to get link activities", "link", req.GetLinkId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	resp := &scrappergrpc.ListLinkActivitiesResponse{
+		Activities: make([]*scrappergrpc.Activity, len(activities)),
+		Size:       int32(len(activities)), //nolint:gosec // as per the requirements
+	}
+
+	for i, activity := range activities {
+		resp.Activities[i] = &scrappergrpc.Activity{
+			Type:        string(activity.Type),
+			Title:       activity.Title,
+			Description: activity.Body,
+			UserName:    activity.UserName,
+			Labels:      activity.Labels,
+			CreatedAt:   timestamppb.New(activity.CreatedAt),
+		}
+	}
+
+	return resp, nil
+}
+
+// GetChatSettings returns the delivery settings of the chat, the defaults if it has not changed them.
+func (s *ScrapperServer) GetChatSettings(
+	ctx context.Context,
+	req *scrappergrpc.GetChatSettingsRequest,
+) (*scrappergrpc.ChatSettings, error) {
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.NotFound); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.digests.GetChatSettings(ctx, req.GetTgChatId())
+	if err != nil {
+		s.Logger.Error("Failed to get settings for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return mapChatSettings(settings), nil
+}
+
+func (s *ScrapperServer) UpdateChatSettings(
+	ctx context.Context,
+	req *scrappergrpc.UpdateChatSettingsRequest,
+) (*scrappergrpc.ChatSettings, error) {
+	settings, err := mapper.MapChatSettingsToDomain(req.GetTgChatId(), &scrappertypes.ChatSettings{
+		DigestMode:    aws.String(req.GetSettings().GetDigestMode()),
+		DigestTime:    aws.String(req.GetSettings().GetDigestTime()),
+		DigestWeekday: aws.String(req.GetSettings().GetDigestWeekday()),
+		Timezone:      aws.String(req.GetSettings().GetTimezone()),
+		QuietStart:    aws.String(req.GetSettings().GetQuietStart()),
+		QuietEnd:      aws.String(req.GetSettings().GetQuietEnd()),
+	}, time.Now())
+
+	var settingsValidateErr *apperrors.SettingsValidateError
+	if errors.As(err, &settingsValidateErr) {
+		s.Logger.Warn("Settings validation error", "error", err)
+		return nil, status.Error(codes.InvalidArgument, settingsValidateErr.Message)
+	}
+
+	if err := s.checkChat(ctx, req.GetTgChatId(), codes.NotFound); err != nil {
+		return nil, err
+	}
+
+	if err := s.digests.UpdateChatSettings(ctx, settings); err != nil {
+		s.Logger.Error("Failed to update settings for chat", "ID", req.GetTgChatId(), "error", err)
+		return nil, status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	return mapChatSettings(settings), nil
+}
+
+// checkChat does the job of the HTTP auth middleware for the link methods, which fail with
+// UNAUTHENTICATED for unknown chats. The chat methods pass NOT_FOUND, like the HTTP API.
+func (s *ScrapperServer) checkChat(ctx context.Context, tgChatID int64, code codes.Code) error {
+	exist, err := s.repository.CheckUserExistence(ctx, tgChatID)
+	if err != nil {
+		s.Logger.Error("Failed to check user existence", "Tg-Chat-Id", tgChatID, "error", err)
+		return status.Error(codes.Internal, ErrDescriptionInternalError)
+	}
+
+	if !exist {
+		s.Logger.Warn("User does not exist", "Tg-Chat-Id", tgChatID)
+		return status.Error(code, ErrDescriptionChatNotExist)
+	}
+
+	return nil
+}
+
+func mapChatSettings(settings *domain.ChatSettings) *scrappergrpc.ChatSettings {
+	resp := &scrappergrpc.ChatSettings{
+		DigestMode:    string(settings.DigestMode),
+		DigestTime:    domain.FormatDigestTime(settings.DigestTime),
+		DigestWeekday: strings.ToLower(settings.DigestWeekday.String()),
+		Timezone:      settings.Timezone,
+	}
+
+	if settings.Quiet() {
+		resp.QuietStart = domain.FormatDigestTime(settings.QuietStart)
+		resp.QuietEnd = domain.FormatDigestTime(settings.QuietEnd)
+	}
+
+	return resp
+}
@@ -23,10 +23,20 @@ const (
 	ErrLinkNotExist         = "link_not_exist"
 	ErrLinkValidationError  = "link_validation_error"
 	ErrLinkTypeNotSupported = "link_type_not_supported"
+	ErrFilterValidation     = "filter_validation_error"
+
+	ErrActivityQueryValidation = "activity_query_validation_error"
+	ErrSettingsValidation      = "settings_validation_error"
 
 	ErrDescriptionLinkNotExist         = "Link not exist"
 	ErrDescriptionLinkValidationError  = "Link validation error"
 	ErrDescriptionLinkTypeNotSupported = "Link type not supported"
+
+	ErrDeadLetterNotExist = "dead_letter_not_exist"
+	ErrInvalidAdminToken  = "invalid_admin_token"
+
+	ErrDescriptionDeadLetterNotExist = "Dead letter not exist"
+	ErrDescriptionInvalidAdminToken  = "Invalid admin token"
 )
 
 func SendSuccessResponse(ctx echo.Context, data any) error {
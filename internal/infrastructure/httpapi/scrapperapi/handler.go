@@ -3,11 +3,15 @@ package scrapperapi
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/labstack/echo/v4"
 
 	"github.com/AFK068/bot/internal/application/mapper"
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/domain/apperrors"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
@@ -20,16 +24,35 @@ type Transactor interface {
 	WithTransaction(ctx context.Context, txFunc func(ctx context.Context) error) error
 }
 
+// DefaultDeadLettersLimit is the number of dead letters returned when no limit is given.
+const DefaultDeadLettersLimit int64 = 100
+
 type ScrapperHandler struct {
 	transactor Transactor
 	repository domain.ChatLinkRepository
+	activities domain.ActivityRepository
+	digests    domain.DigestRepository
+	outbox     domain.OutboxRepository
+	providers  *provider.Registry
 	Logger     *logger.Logger
 }
 
-func NewScrapperHandler(transactor Transactor, repo domain.ChatLinkRepository, log *logger.Logger) *ScrapperHandler {
+func NewScrapperHandler(
+	transactor Transactor,
+	repo domain.ChatLinkRepository,
+	activities domain.ActivityRepository,
+	digests domain.DigestRepository,
+	outbox domain.OutboxRepository,
+	providers *provider.Registry,
+	log *logger.Logger,
+) *ScrapperHandler {
 	return &ScrapperHandler{
 		transactor: transactor,
 		repository: repo,
+		activities: activities,
+		digests:    digests,
+		outbox:     outbox,
+		providers:  providers,
 		Logger:     log,
 	}
 }
@@ -86,6 +109,77 @@ func (h *ScrapperHandler) DeleteTgChatId(ctx echo.Context, id int64) error { //n
 	return SendSuccessResponse(ctx, nil)
 }
 
+// Get chat delivery settings.
+// (GET /tg-chat/{id}/settings).
+func (h *ScrapperHandler) GetTgChatIdSettings( //nolint:revive,stylecheck // according to codgen interface
+	ctx echo.Context,
+	id int64,
+) error {
+	h.Logger.Info("Getting settings for chat", "ID", id)
+
+	exist, err := h.repository.CheckUserExistence(ctx.Request().Context(), id)
+	if err != nil {
+		h.Logger.Error("Failed to check user existence", "ID", id, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	if !exist {
+		h.Logger.Warn("Chat does not exist", "ID", id)
+		return SendNotFoundResponse(ctx, ErrChatNotExist, ErrDescriptionChatNotExist)
+	}
+
+	settings, err := h.digests.GetChatSettings(ctx.Request().Context(), id)
+	if err != nil {
+		h.Logger.Error("Failed to get settings for chat", "ID", id, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	return SendSuccessResponse(ctx, mapChatSettingsResponse(settings))
+}
+
+// Update chat delivery settings.
+// (PUT /tg-chat/{id}/settings).
+func (h *ScrapperHandler) PutTgChatIdSettings( //nolint:revive,stylecheck // according to codgen interface
+	ctx echo.Context,
+	id int64,
+) error {
+	h.Logger.Info("Updating settings for chat", "ID", id)
+
+	var req scrappertypes.ChatSettings
+	if err := ctx.Bind(&req); err != nil {
+		h.Logger.Warn("Invalid request body", "error", err)
+		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
+	}
+
+	settings, err := mapper.MapChatSettingsToDomain(id, &req, time.Now())
+
+	var settingsValidateErr *apperrors.SettingsValidateError
+	if errors.As(err, &settingsValidateErr) {
+		h.Logger.Warn("Settings validation error", "error", err)
+		return SendBadRequestResponse(ctx, ErrSettingsValidation, settingsValidateErr.Message)
+	}
+
+	exist, err := h.repository.CheckUserExistence(ctx.Request().Context(), id)
+	if err != nil {
+		h.Logger.Error("Failed to check user existence", "ID", id, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	if !exist {
+		h.Logger.Warn("Chat does not exist", "ID", id)
+		return SendNotFoundResponse(ctx, ErrChatNotExist, ErrDescriptionChatNotExist)
+	}
+
+	if err := h.digests.UpdateChatSettings(ctx.Request().Context(), settings); err != nil {
+		h.Logger.Error("Failed to update settings for chat", "ID", id, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	h.Logger.Info("Successfully updated settings for chat", "ID", id, "mode", settings.DigestMode)
+
+	return SendSuccessResponse(ctx, mapChatSettingsResponse(settings))
+}
+
 // Add link tracking.
 // (POST /links).
 func (h *ScrapperHandler) PostLinks(ctx echo.Context, params scrappertypes.PostLinksParams) error {
@@ -97,7 +191,7 @@ func (h *ScrapperHandler) PostLinks(ctx echo.Context, params scrappertypes.PostL
 		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
 	}
 
-	link, err := mapper.MapAddLinkRequestToDomain(params.TgChatId, &req)
+	link, err := mapper.MapAddLinkRequestToDomain(h.providers, params.TgChatId, &req)
 
 	var linkValidateErr *apperrors.LinkValidateError
 	if errors.As(err, &linkValidateErr) {
@@ -111,6 +205,12 @@ func (h *ScrapperHandler) PostLinks(ctx echo.Context, params scrappertypes.PostL
 		return SendBadRequestResponse(ctx, ErrLinkTypeNotSupported, ErrDescriptionLinkTypeNotSupported)
 	}
 
+	var filterValidateErr *apperrors.FilterValidateError
+	if errors.As(err, &filterValidateErr) {
+		h.Logger.Warn("Filter validation error", "error", err)
+		return SendBadRequestResponse(ctx, ErrFilterValidation, filterValidateErr.Message)
+	}
+
 	if err != nil {
 		h.Logger.Error("Internal error", "error", err)
 		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
@@ -167,6 +267,81 @@ func (h *ScrapperHandler) DeleteLinks(ctx echo.Context, params scrappertypes.Del
 	return SendSuccessResponse(ctx, nil)
 }
 
+// Mute link updates.
+// (POST /links/mute).
+func (h *ScrapperHandler) PostLinksMute(ctx echo.Context, params scrappertypes.PostLinksMuteParams) error {
+	h.Logger.Info("Muting link for chat", "ID", params.TgChatId)
+
+	var req scrappertypes.MuteLinkRequest
+	if err := ctx.Bind(&req); err != nil {
+		h.Logger.Warn("Invalid request body", "error", err)
+		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
+	}
+
+	if req.Link == nil || *req.Link == "" || aws.Int64Value(req.Duration) < 0 {
+		h.Logger.Warn("Invalid mute request")
+		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
+	}
+
+	var until time.Time
+	if duration := aws.Int64Value(req.Duration); duration > 0 {
+		until = time.Now().Add(time.Duration(duration) * time.Second)
+	}
+
+	err := h.repository.MuteLink(ctx.Request().Context(), params.TgChatId, &domain.Link{URL: *req.Link}, until)
+
+	var linkNotExistErr *apperrors.LinkIsNotExistError
+	if errors.As(err, &linkNotExistErr) {
+		h.Logger.Warn("Link does not exist", "error", err)
+		return SendNotFoundResponse(ctx, ErrLinkNotExist, ErrDescriptionLinkNotExist)
+	}
+
+	if err != nil {
+		h.Logger.Error("Failed to mute link for chat", "ID", params.TgChatId, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	h.Logger.Info("Successfully muted link for chat", "ID", params.TgChatId, "until", until)
+
+	return SendSuccessResponse(ctx, nil)
+}
+
+// Change link urgency.
+// (POST /links/urgent).
+func (h *ScrapperHandler) PostLinksUrgent(ctx echo.Context, params scrappertypes.PostLinksUrgentParams) error {
+	h.Logger.Info("Changing link urgency for chat", "ID", params.TgChatId)
+
+	var req scrappertypes.UrgentLinkRequest
+	if err := ctx.Bind(&req); err != nil {
+		h.Logger.Warn("Invalid request body", "error", err)
+		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
+	}
+
+	if req.Link == nil || *req.Link == "" {
+		h.Logger.Warn("Invalid urgent request")
+		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
+	}
+
+	urgent := aws.BoolValue(req.Urgent)
+
+	err := h.repository.SetLinkUrgent(ctx.Request().Context(), params.TgChatId, &domain.Link{URL: *req.Link}, urgent)
+
+	var linkNotExistErr *apperrors.LinkIsNotExistError
+	if errors.As(err, &linkNotExistErr) {
+		h.Logger.Warn("Link does not exist", "error", err)
+		return SendNotFoundResponse(ctx, ErrLinkNotExist, ErrDescriptionLinkNotExist)
+	}
+
+	if err != nil {
+		h.Logger.Error("Failed to change link urgency for chat", "ID", params.TgChatId, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	h.Logger.Info("Successfully changed link urgency for chat", "ID", params.TgChatId, "urgent", urgent)
+
+	return SendSuccessResponse(ctx, nil)
+}
+
 // Get all tracked links.
 // (GET /links).
 func (h *ScrapperHandler) GetLinks(ctx echo.Context, params scrappertypes.GetLinksParams) error {
@@ -197,10 +372,23 @@ func (h *ScrapperHandler) GetLinks(ctx echo.Context, params scrappertypes.GetLin
 	linksResp := make([]scrappertypes.LinkResponse, len(links))
 	for i, link := range links {
 		linksResp[i] = scrappertypes.LinkResponse{
+			Id:      aws.Int64(link.ID),
 			Url:     aws.String(link.URL),
 			Tags:    utils.SliceStringPtr(link.Tags),
 			Filters: utils.SliceStringPtr(link.Filters),
 		}
+
+		if link.MaxCheckInterval > 0 {
+			linksResp[i].CheckInterval = aws.Int64(int64(link.MaxCheckInterval / time.Second))
+		}
+
+		if link.Broken {
+			linksResp[i].Broken = aws.Bool(true)
+		}
+
+		if link.Urgent {
+			linksResp[i].Urgent = aws.Bool(true)
+		}
 	}
 
 	h.Logger.Info("Successfully retrieved links for chat", "ID", params.TgChatId)
@@ -210,3 +398,139 @@ func (h *ScrapperHandler) GetLinks(ctx echo.Context, params scrappertypes.GetLin
 		Size:  aws.Int32(int32(len(linksResp))), //nolint:gosec // as per the requirements
 	})
 }
+
+// Get link activity history.
+// (GET /links/{id}/activities).
+func (h *ScrapperHandler) GetLinksIdActivities( //nolint:revive,stylecheck // according to codgen interface
+	ctx echo.Context,
+	id int64,
+	params scrappertypes.GetLinksIdActivitiesParams,
+) error {
+	h.Logger.Info("Getting link activities for chat", "ID", params.TgChatId, "link", id)
+
+	query, err := mapper.MapActivityQueryToDomain(h.providers, params.Since, params.Type, params.Limit)
+
+	var queryValidateErr *apperrors.ActivityQueryValidateError
+	if errors.As(err, &queryValidateErr) {
+		h.Logger.Warn("Activity query validation error", "error", err)
+		return SendBadRequestResponse(ctx, ErrActivityQueryValidation, queryValidateErr.Message)
+	}
+
+	links, err := h.repository.GetListLinks(ctx.Request().Context(), params.TgChatId)
+	if err != nil {
+		h.Logger.Error("Failed to get links for chat", "ID", params.TgChatId, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	// The history is shared by the subscribers, other chats must not read it by the link ID.
+	if !slices.ContainsFunc(links, func(link *domain.Link) bool { return link.ID == id }) {
+		h.Logger.Warn("Link is not tracked by chat", "ID", params.TgChatId, "link", id)
+		return SendNotFoundResponse(ctx, ErrLinkNotExist, ErrDescriptionLinkNotExist)
+	}
+
+	activities, err := h.activities.GetActivities(ctx.Request().Context(), id, query)
+	if err != nil {
+		h.Logger.Error("Failed to get link activities", "link", id, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	activitiesResp := make([]scrappertypes.ActivityResponse, len(activities))
+	for i, activity := range activities {
+		activitiesResp[i] = scrappertypes.ActivityResponse{
+			Type:        aws.String(string(activity.Type)),
+			Title:       aws.String(activity.Title),
+			Description: aws.String(activity.Body),
+			UserName:    aws.String(activity.UserName),
+			Labels:      utils.SliceStringPtr(activity.Labels),
+			CreatedAt:   aws.Time(activity.CreatedAt),
+		}
+	}
+
+	return SendSuccessResponse(ctx, scrappertypes.ListActivitiesResponse{
+		Activities: &activitiesResp,
+		Size:       aws.Int32(int32(len(activitiesResp))), //nolint:gosec // as per the requirements
+	})
+}
+
+// List dead letters.
+// (GET /admin/outbox/dead).
+func (h *ScrapperHandler) GetAdminOutboxDead(ctx echo.Context, params scrappertypes.GetAdminOutboxDeadParams) error {
+	limit := DefaultDeadLettersLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	if limit <= 0 {
+		h.Logger.Warn("Invalid dead letters limit", "limit", limit)
+		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
+	}
+
+	messages, err := h.outbox.GetDeadOutboxMessages(ctx.Request().Context(), uint64(limit))
+	if err != nil {
+		h.Logger.Error("Failed to get dead letters", "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	letters := make([]scrappertypes.DeadLetterResponse, len(messages))
+	for i, message := range messages {
+		letters[i] = scrappertypes.DeadLetterResponse{
+			Id:          aws.Int64(message.ID),
+			Url:         message.Update.Url,
+			TgChatIds:   message.Update.TgChatIds,
+			Description: message.Update.Description,
+			Attempts:    aws.Int32(int32(message.Attempts)), //nolint:gosec // bounded by the dispatcher
+			LastError:   aws.String(message.LastError),
+			CreatedAt:   aws.Time(message.CreatedAt),
+		}
+
+		if message.Update.Type != nil {
+			letters[i].Type = aws.String(string(*message.Update.Type))
+		}
+	}
+
+	return SendSuccessResponse(ctx, scrappertypes.ListDeadLettersResponse{
+		Letters: &letters,
+		Size:    aws.Int32(int32(len(letters))), //nolint:gosec // as per the requirements
+	})
+}
+
+// Replay a dead letter.
+// (POST /admin/outbox/dead/{id}/replay).
+func (h *ScrapperHandler) PostAdminOutboxDeadIdReplay( //nolint:revive,stylecheck // according to codgen interface
+	ctx echo.Context,
+	id int64,
+	_ scrappertypes.PostAdminOutboxDeadIdReplayParams,
+) error {
+	h.Logger.Info("Replaying dead letter", "ID", id)
+
+	err := h.outbox.ReplayOutboxMessage(ctx.Request().Context(), id)
+
+	var notExistErr *apperrors.OutboxMessageIsNotExistError
+	if errors.As(err, &notExistErr) {
+		h.Logger.Warn("Dead letter does not exist", "ID", id)
+		return SendNotFoundResponse(ctx, ErrDeadLetterNotExist, ErrDescriptionDeadLetterNotExist)
+	}
+
+	if err != nil {
+		h.Logger.Error("Failed to replay dead letter", "ID", id, "error", err)
+		return SendBadRequestResponse(ctx, ErrInternalError, ErrDescriptionInternalError)
+	}
+
+	return SendSuccessResponse(ctx, nil)
+}
+
+func mapChatSettingsResponse(settings *domain.ChatSettings) scrappertypes.ChatSettings {
+	resp := scrappertypes.ChatSettings{
+		DigestMode:    aws.String(string(settings.DigestMode)),
+		DigestTime:    aws.String(domain.FormatDigestTime(settings.DigestTime)),
+		DigestWeekday: aws.String(strings.ToLower(settings.DigestWeekday.String())),
+		Timezone:      aws.String(settings.Timezone),
+	}
+
+	if settings.Quiet() {
+		resp.QuietStart = aws.String(domain.FormatDigestTime(settings.QuietStart))
+		resp.QuietEnd = aws.String(domain.FormatDigestTime(settings.QuietEnd))
+	}
+
+	return resp
+}
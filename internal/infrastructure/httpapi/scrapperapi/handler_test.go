@@ -7,26 +7,41 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
+	"github.com/AFK068/bot/internal/application/provider"
 	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/domain/apperrors"
 	"github.com/AFK068/bot/internal/infrastructure/httpapi/scrapperapi"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
 	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
 	repomock "github.com/AFK068/bot/internal/domain/mocks"
 	transactor "github.com/AFK068/bot/internal/infrastructure/httpapi/scrapperapi/mocks"
 )
 
+// newRegistry returns the built-in providers, their clients are not used by the handlers.
+func newRegistry(t *testing.T) *provider.Registry {
+	registry, err := provider.NewRegistry(
+		provider.NewGitHubProvider(nil, logger.NewDiscardLogger()),
+		provider.NewStackOverflowProvider(nil, logger.NewDiscardLogger()),
+	)
+	require.NoError(t, err)
+
+	return registry
+}
+
 func Test_PostTgChatId_Success(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
 
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
 	repoMock.On("RegisterChat", mock.Anything, int64(123)).Return(nil)
@@ -46,7 +61,7 @@ func Test_PostTgChatId_Success(t *testing.T) {
 
 func Test_PostTgChatId_AlreadyExists(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
 
@@ -65,7 +80,7 @@ func Test_PostTgChatId_AlreadyExists(t *testing.T) {
 
 func Test_PostTgChatId_Failure(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
 	repoMock.On("RegisterChat", mock.Anything, int64(123)).Return(assert.AnError)
@@ -85,7 +100,7 @@ func Test_PostTgChatId_Failure(t *testing.T) {
 
 func Test_DeleteTgChatId_Success(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
 	repoMock.On("DeleteChat", mock.Anything, int64(123)).Return(nil)
@@ -105,7 +120,7 @@ func Test_DeleteTgChatId_Success(t *testing.T) {
 
 func Test_DeleteTgChatId_UserNotFound(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
 
@@ -124,7 +139,7 @@ func Test_DeleteTgChatId_UserNotFound(t *testing.T) {
 
 func Test_DeleteTgChatId_Failure(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
 	repoMock.On("DeleteChat", mock.Anything, int64(123)).Return(assert.AnError)
@@ -142,13 +157,107 @@ func Test_DeleteTgChatId_Failure(t *testing.T) {
 	repoMock.AssertExpectations(t)
 }
 
+func Test_GetTgChatIdSettings_Success(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	digestsMock := repomock.NewDigestRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, digestsMock, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	digestsMock.On("GetChatSettings", mock.Anything, int64(123)).Return(&domain.ChatSettings{
+		ChatID:        123,
+		DigestMode:    domain.DigestDaily,
+		DigestTime:    9*time.Hour + 30*time.Minute,
+		DigestWeekday: time.Monday,
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/tg-chat/123/settings", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetTgChatIdSettings(c, 123)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp scrappertypes.ChatSettings
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, "daily", *resp.DigestMode)
+	assert.Equal(t, "09:30", *resp.DigestTime)
+	assert.Equal(t, "monday", *resp.DigestWeekday)
+}
+
+func Test_GetTgChatIdSettings_UserNotFound(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(false, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/tg-chat/123/settings", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetTgChatIdSettings(c, 123)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func Test_PutTgChatIdSettings_Success(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	digestsMock := repomock.NewDigestRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, digestsMock, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	repoMock.On("CheckUserExistence", mock.Anything, int64(123)).Return(true, nil)
+	digestsMock.On("UpdateChatSettings", mock.Anything, mock.MatchedBy(func(settings *domain.ChatSettings) bool {
+		return settings.ChatID == 123 && settings.DigestMode == domain.DigestWeekly &&
+			settings.DigestWeekday == time.Friday && settings.DigestTime == 18*time.Hour && settings.NextDigestAt.After(time.Now())
+	})).Return(nil)
+
+	reqBody, err := json.Marshal(scrappertypes.ChatSettings{
+		DigestMode:    aws.String("weekly"),
+		DigestTime:    aws.String("18:00"),
+		DigestWeekday: aws.String("fri"),
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, "/tg-chat/123/settings", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PutTgChatIdSettings(c, 123)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	repoMock.AssertExpectations(t)
+	digestsMock.AssertExpectations(t)
+}
+
+func Test_PutTgChatIdSettings_InvalidSettings(t *testing.T) {
+	h := scrapperapi.NewScrapperHandler(nil, nil, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	reqBody, err := json.Marshal(scrappertypes.ChatSettings{DigestMode: aws.String("daily"), DigestTime: aws.String("25:00")})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, "/tg-chat/123/settings", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PutTgChatIdSettings(c, 123)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), scrapperapi.ErrSettingsValidation)
+}
+
 func Test_PostLinks_Success(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
 	transactorMock := transactor.NewTransactor(t)
-	h := scrapperapi.NewScrapperHandler(transactorMock, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(
+		transactorMock, repoMock, nil, nil, repomock.NewOutboxRepository(t), newRegistry(t), logger.NewDiscardLogger(),
+	)
 
 	body := scrappertypes.AddLinkRequest{
-		Link:    aws.String("https://github.com"),
+		Link:    aws.String("https://github.com/test/test"),
 		Tags:    &[]string{"tag1"},
 		Filters: &[]string{"filter1"},
 	}
@@ -182,7 +291,7 @@ func Test_PostLinks_Success(t *testing.T) {
 
 func Test_PostLinks_InvalidLink(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	body := scrappertypes.AddLinkRequest{
 		Link:    aws.String("test"),
@@ -206,13 +315,44 @@ func Test_PostLinks_InvalidLink(t *testing.T) {
 	repoMock.AssertExpectations(t)
 }
 
+func Test_PostLinks_InvalidFilter(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.AddLinkRequest{
+		Link:    aws.String("https://github.com/test/test"),
+		Filters: &[]string{"author:octocat"},
+	}
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links?TgChatId=123", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinks(c, scrappertypes.PostLinksParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var resp scrappertypes.ApiErrorResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, scrapperapi.ErrFilterValidation, *resp.ExceptionMessage)
+	repoMock.AssertExpectations(t)
+}
+
 func Test_PostLinks_Failure(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
 	transactorMock := transactor.NewTransactor(t)
-	h := scrapperapi.NewScrapperHandler(transactorMock, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(
+		transactorMock, repoMock, nil, nil, repomock.NewOutboxRepository(t), newRegistry(t), logger.NewDiscardLogger(),
+	)
 
 	body := scrappertypes.AddLinkRequest{
-		Link:    aws.String("https://github.com"),
+		Link:    aws.String("https://github.com/test/test"),
 		Tags:    &[]string{"tag1"},
 		Filters: &[]string{"filter1"},
 	}
@@ -247,10 +387,12 @@ func Test_PostLinks_Failure(t *testing.T) {
 func Test_PostLinks_DuplicateLink(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
 	transactorMock := transactor.NewTransactor(t)
-	h := scrapperapi.NewScrapperHandler(transactorMock, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(
+		transactorMock, repoMock, nil, nil, repomock.NewOutboxRepository(t), newRegistry(t), logger.NewDiscardLogger(),
+	)
 
 	body := scrappertypes.AddLinkRequest{
-		Link: aws.String("https://github.com"),
+		Link: aws.String("https://github.com/test/test"),
 	}
 
 	ctx := context.Background()
@@ -295,7 +437,7 @@ func Test_PostLinks_DuplicateLink(t *testing.T) {
 
 func Test_DeleteLinks_Success(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	body := scrappertypes.RemoveLinkRequest{
 		Link: aws.String("https://github.com"),
@@ -321,7 +463,7 @@ func Test_DeleteLinks_Success(t *testing.T) {
 
 func Test_DeleteLinks_InvalidLink(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	body := scrappertypes.RemoveLinkRequest{
 		Link: aws.String(""),
@@ -345,7 +487,7 @@ func Test_DeleteLinks_InvalidLink(t *testing.T) {
 
 func Test_DeleteLinks_LinkNotExist(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	body := scrappertypes.RemoveLinkRequest{
 		Link: aws.String("test"),
@@ -371,7 +513,7 @@ func Test_DeleteLinks_LinkNotExist(t *testing.T) {
 
 func Test_DeleteLinks_Failure(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	body := scrappertypes.RemoveLinkRequest{
 		Link: aws.String("https://github.com"),
@@ -395,12 +537,175 @@ func Test_DeleteLinks_Failure(t *testing.T) {
 	repoMock.AssertExpectations(t)
 }
 
+func Test_PostLinksMute_Success(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.MuteLinkRequest{
+		Link:     aws.String("https://github.com/owner/repo"),
+		Duration: aws.Int64(3600),
+	}
+
+	repoMock.On("MuteLink", mock.Anything, int64(123), &domain.Link{URL: "https://github.com/owner/repo"},
+		mock.MatchedBy(func(until time.Time) bool {
+			return until.After(time.Now().Add(59*time.Minute)) && until.Before(time.Now().Add(61*time.Minute))
+		})).Return(nil)
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links/mute", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinksMute(c, scrappertypes.PostLinksMuteParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	repoMock.AssertExpectations(t)
+}
+
+func Test_PostLinksMute_Unmute(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.MuteLinkRequest{
+		Link: aws.String("https://github.com/owner/repo"),
+	}
+
+	repoMock.On("MuteLink", mock.Anything, int64(123), &domain.Link{URL: "https://github.com/owner/repo"}, time.Time{}).Return(nil)
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links/mute", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinksMute(c, scrappertypes.PostLinksMuteParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	repoMock.AssertExpectations(t)
+}
+
+func Test_PostLinksMute_InvalidDuration(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.MuteLinkRequest{
+		Link:     aws.String("https://github.com/owner/repo"),
+		Duration: aws.Int64(-1),
+	}
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links/mute", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinksMute(c, scrappertypes.PostLinksMuteParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	repoMock.AssertExpectations(t)
+}
+
+func Test_PostLinksMute_LinkNotExist(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.MuteLinkRequest{
+		Link:     aws.String("https://github.com/owner/repo"),
+		Duration: aws.Int64(3600),
+	}
+
+	repoMock.On("MuteLink", mock.Anything, int64(123), mock.AnythingOfType("*domain.Link"), mock.AnythingOfType("time.Time")).
+		Return(&apperrors.LinkIsNotExistError{})
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links/mute", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinksMute(c, scrappertypes.PostLinksMuteParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	repoMock.AssertExpectations(t)
+}
+
+func Test_PostLinksUrgent_Success(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.UrgentLinkRequest{
+		Link:   aws.String("https://github.com/owner/repo"),
+		Urgent: aws.Bool(true),
+	}
+
+	// The link is not saved again, so the last check of the chat is kept.
+	repoMock.On("SetLinkUrgent", mock.Anything, int64(123), &domain.Link{URL: "https://github.com/owner/repo"}, true).Return(nil)
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links/urgent", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinksUrgent(c, scrappertypes.PostLinksUrgentParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	repoMock.AssertExpectations(t)
+	repoMock.AssertNotCalled(t, "SaveLink", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func Test_PostLinksUrgent_LinkNotExist(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	body := scrappertypes.UrgentLinkRequest{Link: aws.String("https://github.com/owner/repo")}
+
+	repoMock.On("SetLinkUrgent", mock.Anything, int64(123), mock.AnythingOfType("*domain.Link"), false).
+		Return(&apperrors.LinkIsNotExistError{})
+
+	reqBody, err := json.Marshal(body)
+	assert.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/links/urgent", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostLinksUrgent(c, scrappertypes.PostLinksUrgentParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	repoMock.AssertExpectations(t)
+}
+
 func Test_GetLinks_WithoutTag_Success(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	expectedLinks := []*domain.Link{
-		{URL: "https://test", Tags: []string{"test_tag"}},
+		{ID: 7, URL: "https://test", Tags: []string{"test_tag"}, MaxCheckInterval: 10 * time.Minute, Broken: true},
 	}
 
 	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return(expectedLinks, nil)
@@ -421,14 +726,17 @@ func Test_GetLinks_WithoutTag_Success(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Len(t, *resp.Links, 1)
+	assert.Equal(t, int64(7), *(*resp.Links)[0].Id)
 	assert.Equal(t, expectedLinks[0].URL, *(*resp.Links)[0].Url)
 	assert.Equal(t, expectedLinks[0].Tags, *(*resp.Links)[0].Tags)
+	assert.Equal(t, int64(600), *(*resp.Links)[0].CheckInterval)
+	assert.True(t, *(*resp.Links)[0].Broken)
 	repoMock.AssertExpectations(t)
 }
 
 func Test_GetLinks_WithTag_Success(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	expectedLinks := []*domain.Link{
 		{URL: "https://test", Tags: []string{"test_tag"}},
@@ -459,7 +767,7 @@ func Test_GetLinks_WithTag_Success(t *testing.T) {
 
 func Test_GetLinks_EmptyList(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return([]*domain.Link{}, nil)
 
@@ -484,7 +792,7 @@ func Test_GetLinks_EmptyList(t *testing.T) {
 
 func Test_GetLinks_Failure(t *testing.T) {
 	repoMock := repomock.NewChatLinkRepository(t)
-	h := scrapperapi.NewScrapperHandler(nil, repoMock, logger.NewDiscardLogger())
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
 
 	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return(nil, assert.AnError)
 
@@ -500,3 +808,147 @@ func Test_GetLinks_Failure(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, rec.Code)
 	repoMock.AssertExpectations(t)
 }
+
+func Test_GetLinksIdActivities_Success(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	activitiesMock := repomock.NewActivityRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, repoMock, activitiesMock, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	createdAt := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return([]*domain.Link{{ID: 7, URL: "https://github.com/test/test"}}, nil)
+	activitiesMock.On("GetActivities", mock.Anything, int64(7), domain.ActivityQuery{
+		Types: []domain.ActivityType{domain.GitHubIssue},
+		Limit: 5,
+	}).Return([]*domain.Activity{
+		{Type: domain.GitHubIssue, Title: "Crash", Body: "Crash on start", UserName: "octocat", CreatedAt: createdAt},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/links/7/activities?type=issue&limit=5", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetLinksIdActivities(c, 7, scrappertypes.GetLinksIdActivitiesParams{
+		TgChatId: 123,
+		Type:     aws.String("issue"),
+		Limit:    aws.Int64(5),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp scrappertypes.ListActivitiesResponse
+	err = json.NewDecoder(rec.Body).Decode(&resp)
+	assert.NoError(t, err)
+
+	require.Len(t, *resp.Activities, 1)
+	assert.Equal(t, string(domain.GitHubIssue), *(*resp.Activities)[0].Type)
+	assert.Equal(t, "Crash on start", *(*resp.Activities)[0].Description)
+	assert.Equal(t, createdAt, *(*resp.Activities)[0].CreatedAt)
+	repoMock.AssertExpectations(t)
+	activitiesMock.AssertExpectations(t)
+}
+
+func Test_GetLinksIdActivities_LinkNotTracked(t *testing.T) {
+	repoMock := repomock.NewChatLinkRepository(t)
+	h := scrapperapi.NewScrapperHandler(
+		nil, repoMock, repomock.NewActivityRepository(t), nil, nil, newRegistry(t), logger.NewDiscardLogger(),
+	)
+
+	repoMock.On("GetListLinks", mock.Anything, int64(123)).Return([]*domain.Link{{ID: 8, URL: "https://github.com/test/test"}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/links/7/activities", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetLinksIdActivities(c, 7, scrappertypes.GetLinksIdActivitiesParams{TgChatId: 123})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	repoMock.AssertExpectations(t)
+}
+
+func Test_GetLinksIdActivities_UnknownType(t *testing.T) {
+	h := scrapperapi.NewScrapperHandler(nil, nil, nil, nil, nil, newRegistry(t), logger.NewDiscardLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/links/7/activities?type=wiki", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetLinksIdActivities(c, 7, scrappertypes.GetLinksIdActivitiesParams{TgChatId: 123, Type: aws.String("wiki")})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_GetAdminOutboxDead_Success(t *testing.T) {
+	outboxMock := repomock.NewOutboxRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, nil, nil, nil, outboxMock, newRegistry(t), logger.NewDiscardLogger())
+
+	outboxMock.On("GetDeadOutboxMessages", mock.Anything, uint64(10)).Return([]*domain.OutboxMessage{
+		{
+			ID:        1,
+			Update:    bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")},
+			Status:    domain.OutboxStatusDead,
+			Attempts:  8,
+			LastError: "bot is unavailable",
+		},
+	}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/outbox/dead?limit=10", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetAdminOutboxDead(c, scrappertypes.GetAdminOutboxDeadParams{Limit: aws.Int64(10)})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var resp scrappertypes.ListDeadLettersResponse
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, int32(1), *resp.Size)
+	assert.Equal(t, "https://github.com/test/test", *(*resp.Letters)[0].Url)
+	assert.Equal(t, "bot is unavailable", *(*resp.Letters)[0].LastError)
+}
+
+func Test_GetAdminOutboxDead_InvalidLimit(t *testing.T) {
+	h := scrapperapi.NewScrapperHandler(nil, nil, nil, nil, repomock.NewOutboxRepository(t), newRegistry(t), logger.NewDiscardLogger())
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/outbox/dead?limit=0", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.GetAdminOutboxDead(c, scrappertypes.GetAdminOutboxDeadParams{Limit: aws.Int64(0)})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func Test_PostAdminOutboxDeadIdReplay_Success(t *testing.T) {
+	outboxMock := repomock.NewOutboxRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, nil, nil, nil, outboxMock, newRegistry(t), logger.NewDiscardLogger())
+
+	outboxMock.On("ReplayOutboxMessage", mock.Anything, int64(1)).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/outbox/dead/1/replay", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.PostAdminOutboxDeadIdReplay(c, 1, scrappertypes.PostAdminOutboxDeadIdReplayParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_PostAdminOutboxDeadIdReplay_NotFound(t *testing.T) {
+	outboxMock := repomock.NewOutboxRepository(t)
+	h := scrapperapi.NewScrapperHandler(nil, nil, nil, nil, outboxMock, newRegistry(t), logger.NewDiscardLogger())
+
+	outboxMock.On("ReplayOutboxMessage", mock.Anything, int64(1)).
+		Return(&apperrors.OutboxMessageIsNotExistError{Message: "Dead outbox message is not exist"})
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/outbox/dead/1/replay", http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err := h.PostAdminOutboxDeadIdReplay(c, 1, scrappertypes.PostAdminOutboxDeadIdReplayParams{})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
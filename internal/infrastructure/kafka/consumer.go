@@ -0,0 +1,205 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	telegramapi "github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
+)
+
+const (
+	// RetryDelay is the pause before retrying a failed commit or dead letter publish,
+	// and the longest pause before fetching again after a failed fetch.
+	RetryDelay = 5 * time.Second
+
+	// HandleAttempts is the number of times an update is handled before it is moved to the dead letter topic.
+	HandleAttempts = 5
+	// HandleBackoff is the pause before handling a failed update again, doubled after every attempt.
+	HandleBackoff = time.Second
+
+	// Headers attached to dead letters.
+	HeaderError     = "error"
+	HeaderTopic     = "topic"
+	HeaderPartition = "partition"
+	HeaderOffset    = "offset"
+)
+
+// Handler processes one link update. Updates it fails HandleAttempts times, or rejects
+// as invalid, are moved to the dead letter topic.
+type Handler func(ctx context.Context, update bottypes.LinkUpdate) error
+
+// Consumer reads link updates as a member of a consumer group. Offsets are committed
+// only after an update is handled or moved to the dead letter topic, so every update
+// is processed at least once.
+type Consumer struct {
+	// HandleBackoff is the pause before the second attempt to handle an update.
+	HandleBackoff time.Duration
+
+	reader  *kafka.Reader
+	dlq     *kafka.Writer
+	handler Handler
+	logger  *logger.Logger
+}
+
+func NewConsumer(cfg config.Kafka, handler Handler, log *logger.Logger) *Consumer {
+	return &Consumer{
+		HandleBackoff: HandleBackoff,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     cfg.Brokers,
+			GroupID:     cfg.GroupID,
+			Topic:       cfg.Topic,
+			StartOffset: kafka.FirstOffset,
+		}),
+		dlq: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.Brokers...),
+			Topic:                  cfg.DLQTopic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			BatchTimeout:           10 * time.Millisecond,
+			AllowAutoTopicCreation: true,
+		},
+		handler: handler,
+		logger:  log,
+	}
+}
+
+// Run consumes updates until the context is canceled. Failed fetches, commits and dead letter
+// publishes are retried, so a broker outage pauses the consumer instead of stopping it.
+func (c *Consumer) Run(ctx context.Context) error {
+	c.logger.Info("Starting link updates consumer", "topic", c.reader.Config().Topic, "group", c.reader.Config().GroupID)
+
+	for {
+		message, err := c.fetch(ctx)
+		if err != nil {
+			return nil
+		}
+
+		if handleErr := c.handle(ctx, message); handleErr != nil {
+			// The update was not handled, it stays uncommitted and is redelivered to the group.
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			c.logger.Error("Failed to handle update, moving it to the dead letter topic",
+				"partition", message.Partition, "offset", message.Offset, "error", handleErr)
+
+			if err := retry(ctx, c.logger, func() error { return c.publishDeadLetter(ctx, message, handleErr) }); err != nil {
+				return nil
+			}
+		}
+
+		if err := retry(ctx, c.logger, func() error { return c.reader.CommitMessages(ctx, message) }); err != nil {
+			return nil
+		}
+	}
+}
+
+// fetch fetches the next message, fetching again with backoff after a failure,
+// such as a broker being unavailable, until the context is canceled.
+func (c *Consumer) fetch(ctx context.Context) (kafka.Message, error) {
+	backoff := c.HandleBackoff
+
+	for {
+		message, err := c.reader.FetchMessage(ctx)
+		if err == nil {
+			return message, nil
+		}
+
+		if ctx.Err() != nil {
+			return kafka.Message{}, ctx.Err()
+		}
+
+		c.logger.Error("Failed to fetch message, retrying", "delay", backoff.String(), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return kafka.Message{}, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, RetryDelay)
+	}
+}
+
+func (c *Consumer) Close() error {
+	return errors.Join(c.reader.Close(), c.dlq.Close())
+}
+
+// handle decodes and handles the update, handling it again with backoff after a failure.
+// Undecodable updates and updates rejected as invalid are not retried, they cannot succeed.
+func (c *Consumer) handle(ctx context.Context, message kafka.Message) error {
+	var update bottypes.LinkUpdate
+	if err := json.Unmarshal(message.Value, &update); err != nil {
+		return fmt.Errorf("unmarshaling link update: %w", err)
+	}
+
+	backoff := c.HandleBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := c.handler(ctx, update)
+
+		var invalidErr *telegramapi.InvalidUpdateError
+		if err == nil || errors.As(err, &invalidErr) || attempt >= HandleAttempts {
+			return err
+		}
+
+		c.logger.Warn("Failed to handle update, retrying",
+			"partition", message.Partition, "offset", message.Offset, "attempt", attempt, "delay", backoff.String(), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+func (c *Consumer) publishDeadLetter(ctx context.Context, message kafka.Message, handleErr error) error {
+	deadLetter := kafka.Message{
+		Key:   message.Key,
+		Value: message.Value,
+		Headers: append(message.Headers,
+			kafka.Header{Key: HeaderError, Value: []byte(handleErr.Error())},
+			kafka.Header{Key: HeaderTopic, Value: []byte(message.Topic)},
+			kafka.Header{Key: HeaderPartition, Value: []byte(strconv.Itoa(message.Partition))},
+			kafka.Header{Key: HeaderOffset, Value: []byte(strconv.FormatInt(message.Offset, 10))},
+		),
+	}
+
+	if err := c.dlq.WriteMessages(ctx, deadLetter); err != nil {
+		return fmt.Errorf("publishing dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// retry calls fn until it succeeds or the context is canceled, in which case the
+// message stays uncommitted and is redelivered to the group.
+func retry(ctx context.Context, log *logger.Logger, fn func() error) error {
+	for {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		log.Error("Kafka operation failed, retrying", "delay", RetryDelay.String(), "error", err)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(RetryDelay):
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package kafka_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/internal/testcontainer"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	kafkatransport "github.com/AFK068/bot/internal/infrastructure/kafka"
+	telegramapi "github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
+)
+
+func setupKafka(t *testing.T) (config.Kafka, context.Context) {
+	ctx := context.Background()
+
+	testContainer, err := testcontainer.NewKafkaTestcontainer(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, testContainer.Stop())
+	})
+
+	brokers, err := testContainer.Brokers(ctx)
+	require.NoError(t, err)
+
+	cfg := config.Kafka{
+		Brokers:  brokers,
+		Topic:    config.DefaultKafkaTopic,
+		DLQTopic: config.DefaultKafkaDLQTopic,
+		GroupID:  config.DefaultKafkaGroupID,
+	}
+
+	require.NoError(t, testContainer.CreateTopics(ctx, cfg.Topic, cfg.DLQTopic))
+
+	return cfg, ctx
+}
+
+func runConsumer(t *testing.T, ctx context.Context, cfg config.Kafka, handler kafkatransport.Handler) {
+	ctx, cancel := context.WithCancel(ctx)
+	consumer := kafkatransport.NewConsumer(cfg, handler, logger.NewDiscardLogger())
+	consumer.HandleBackoff = 10 * time.Millisecond
+
+	done := make(chan error, 1)
+
+	go func() {
+		done <- consumer.Run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+		assert.NoError(t, consumer.Close())
+	})
+}
+
+func Test_PostUpdates_Consumed(t *testing.T) {
+	cfg, ctx := setupKafka(t)
+
+	update := bottypes.LinkUpdate{
+		Id:          aws.Int64(1),
+		Url:         aws.String("https://github.com/test/test"),
+		Description: aws.String("description"),
+		TgChatIds:   &[]int64{1, 2},
+	}
+
+	received := make(chan bottypes.LinkUpdate, 1)
+
+	runConsumer(t, ctx, cfg, func(_ context.Context, update bottypes.LinkUpdate) error {
+		received <- update
+		return nil
+	})
+
+	producer := kafkatransport.NewProducer(cfg, logger.NewDiscardLogger())
+	defer producer.Close()
+
+	require.NoError(t, producer.PostUpdates(ctx, update))
+
+	select {
+	case got := <-received:
+		assert.Equal(t, update, got)
+	case <-time.After(30 * time.Second):
+		t.Fatal("update was not consumed")
+	}
+}
+
+func Test_FailedUpdate_MovedToDLQ(t *testing.T) {
+	cfg, ctx := setupKafka(t)
+
+	update := bottypes.LinkUpdate{
+		Url:       aws.String("https://github.com/test/test"),
+		TgChatIds: &[]int64{1},
+	}
+
+	var attempts atomic.Int32
+
+	runConsumer(t, ctx, cfg, func(context.Context, bottypes.LinkUpdate) error {
+		attempts.Add(1)
+		return assert.AnError
+	})
+
+	producer := kafkatransport.NewProducer(cfg, logger.NewDiscardLogger())
+	defer producer.Close()
+
+	require.NoError(t, producer.PostUpdates(ctx, update))
+
+	message := readDeadLetter(t, ctx, cfg)
+	assert.Equal(t, int32(kafkatransport.HandleAttempts), attempts.Load())
+
+	var got bottypes.LinkUpdate
+	require.NoError(t, json.Unmarshal(message.Value, &got))
+	assert.Equal(t, update, got)
+
+	assert.Equal(t, assert.AnError.Error(), header(message, kafkatransport.HeaderError))
+	assert.Equal(t, cfg.Topic, header(message, kafkatransport.HeaderTopic))
+}
+
+func Test_InvalidUpdate_MovedToDLQWithoutRetries(t *testing.T) {
+	cfg, ctx := setupKafka(t)
+
+	var attempts atomic.Int32
+
+	runConsumer(t, ctx, cfg, func(context.Context, bottypes.LinkUpdate) error {
+		attempts.Add(1)
+		return &telegramapi.InvalidUpdateError{Code: telegramapi.ErrLinkIsEmpty, Description: telegramapi.ErrLinkIsEmptyDescription}
+	})
+
+	producer := kafkatransport.NewProducer(cfg, logger.NewDiscardLogger())
+	defer producer.Close()
+
+	require.NoError(t, producer.PostUpdates(ctx, bottypes.LinkUpdate{TgChatIds: &[]int64{1}}))
+
+	message := readDeadLetter(t, ctx, cfg)
+	assert.Equal(t, int32(1), attempts.Load())
+	assert.Equal(t, telegramapi.ErrLinkIsEmptyDescription, header(message, kafkatransport.HeaderError))
+}
+
+func Test_FailedUpdate_Retried(t *testing.T) {
+	cfg, ctx := setupKafka(t)
+
+	var attempts atomic.Int32
+
+	handled := make(chan struct{})
+
+	runConsumer(t, ctx, cfg, func(context.Context, bottypes.LinkUpdate) error {
+		if attempts.Add(1) < kafkatransport.HandleAttempts {
+			return assert.AnError
+		}
+
+		close(handled)
+
+		return nil
+	})
+
+	producer := kafkatransport.NewProducer(cfg, logger.NewDiscardLogger())
+	defer producer.Close()
+
+	require.NoError(t, producer.PostUpdates(ctx, bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")}))
+
+	select {
+	case <-handled:
+	case <-time.After(30 * time.Second):
+		t.Fatal("update was not handled")
+	}
+}
+
+func readDeadLetter(t *testing.T, ctx context.Context, cfg config.Kafka) kafka.Message {
+	dlq := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: cfg.Brokers,
+		Topic:   cfg.DLQTopic,
+	})
+	defer dlq.Close()
+
+	readCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	message, err := dlq.ReadMessage(readCtx)
+	require.NoError(t, err)
+
+	return message
+}
+
+func header(message kafka.Message, key string) string {
+	for _, h := range message.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
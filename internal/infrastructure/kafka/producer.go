@@ -0,0 +1,63 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+// Producer publishes link updates to the topic consumed by the bot.
+// It implements the bot client interface, so the scrapper can use it instead of the HTTP client.
+type Producer struct {
+	writer *kafka.Writer
+	logger *logger.Logger
+}
+
+func NewProducer(cfg config.Kafka, log *logger.Logger) *Producer {
+	return &Producer{
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(cfg.Brokers...),
+			Topic:                  cfg.Topic,
+			Balancer:               &kafka.Hash{},
+			RequiredAcks:           kafka.RequireAll,
+			BatchTimeout:           10 * time.Millisecond,
+			AllowAutoTopicCreation: true,
+		},
+		logger: log,
+	}
+}
+
+// PostUpdates returns once the update is acknowledged by all in-sync replicas.
+// Updates of the same link are keyed by its URL and keep their order.
+func (p *Producer) PostUpdates(ctx context.Context, update bottypes.LinkUpdate) error {
+	value, err := json.Marshal(update)
+	if err != nil {
+		return fmt.Errorf("marshaling link update: %w", err)
+	}
+
+	message := kafka.Message{Value: value}
+	if update.Url != nil {
+		message.Key = []byte(*update.Url)
+	}
+
+	if err := p.writer.WriteMessages(ctx, message); err != nil {
+		p.logger.Error("Failed to publish update", "topic", p.writer.Topic, "error", err)
+		return fmt.Errorf("publishing link update: %w", err)
+	}
+
+	p.logger.Info("Update published successfully", "topic", p.writer.Topic)
+
+	return nil
+}
+
+func (p *Producer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,195 @@
+package activityrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/pkg/txs"
+)
+
+type timeGetter func() time.Time
+
+type Repository struct {
+	TimeGetter timeGetter
+	db         *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db:         db,
+		TimeGetter: time.Now,
+	}
+}
+
+// GetSeenRevisions returns the revisions of the already seen activities of the link
+// among the given source IDs, keyed by the source ID.
+func (r *Repository) GetSeenRevisions(ctx context.Context, link *domain.Link, sourceIDs []string) (map[string]string, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	SELECT sa.source_id, sa.revision
+	FROM seen_activities sa
+	JOIN links l ON sa.link_id = l.id
+	WHERE l.url = $1 AND sa.source_id = ANY($2);
+	`
+
+	rows, err := querier.Query(ctx, query, link.URL, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting seen activities: %w", err)
+	}
+
+	defer rows.Close()
+
+	revisions := make(map[string]string, len(sourceIDs))
+
+	for rows.Next() {
+		var sourceID, revision string
+
+		if err := rows.Scan(&sourceID, &revision); err != nil {
+			return nil, fmt.Errorf("scanning seen activity: %w", err)
+		}
+
+		revisions[sourceID] = revision
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// SaveSeenActivities stores the revisions of the activities of the link. It should be called
+// in the transaction that enqueues the updates, activities without a source ID are skipped.
+func (r *Repository) SaveSeenActivities(ctx context.Context, link *domain.Link, activities []*domain.Activity) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	// A source reported twice in one check keeps its last revision,
+	// the upsert cannot touch the same row twice.
+	revisions := make(map[string]string, len(activities))
+	sourceIDs := make([]string, 0, len(activities))
+
+	for _, activity := range activities {
+		if activity.SourceID == "" {
+			continue
+		}
+
+		if _, ok := revisions[activity.SourceID]; !ok {
+			sourceIDs = append(sourceIDs, activity.SourceID)
+		}
+
+		revisions[activity.SourceID] = activity.Revision
+	}
+
+	if len(sourceIDs) == 0 {
+		return nil
+	}
+
+	revisionValues := make([]string, len(sourceIDs))
+	for i, sourceID := range sourceIDs {
+		revisionValues[i] = revisions[sourceID]
+	}
+
+	query := `
+	INSERT INTO seen_activities (link_id, source_id, revision, seen_at)
+	SELECT l.id, a.source_id, a.revision, $4
+	FROM links l, unnest($2::TEXT[], $3::TEXT[]) AS a(source_id, revision)
+	WHERE l.url = $1
+	ON CONFLICT (link_id, source_id) DO UPDATE SET revision = EXCLUDED.revision, seen_at = EXCLUDED.seen_at;
+	`
+
+	if _, err := querier.Exec(ctx, query, link.URL, sourceIDs, revisionValues, r.TimeGetter()); err != nil {
+		return fmt.Errorf("saving seen activities: %w", err)
+	}
+
+	return nil
+}
+
+// AddActivities stores the detected activities of the link in its history.
+func (r *Repository) AddActivities(ctx context.Context, link *domain.Link, activities []*domain.Activity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	INSERT INTO activities (link_id, source_id, type, title, body, user_name, labels, created_at, detected_at)
+	SELECT l.id, $2, $3, $4, $5, $6, $7, $8, $9
+	FROM links l
+	WHERE l.url = $1;
+	`
+
+	detectedAt := r.TimeGetter()
+
+	batch := &pgx.Batch{}
+
+	for _, activity := range activities {
+		labels := activity.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+
+		batch.Queue(
+			query,
+			link.URL, activity.SourceID, string(activity.Type), activity.Title, activity.Body,
+			activity.UserName, labels, activity.CreatedAt, detectedAt,
+		)
+	}
+
+	if err := querier.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("adding activities: %w", err)
+	}
+
+	return nil
+}
+
+// GetActivities returns the stored activities of the link selected by the query, the newest first.
+func (r *Repository) GetActivities(ctx context.Context, linkID int64, activityQuery domain.ActivityQuery) ([]*domain.Activity, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	types := make([]string, len(activityQuery.Types))
+	for i, activityType := range activityQuery.Types {
+		types[i] = string(activityType)
+	}
+
+	query := `
+	SELECT type, source_id, title, created_at, body, user_name, labels
+	FROM activities
+	WHERE link_id = $1 AND created_at >= $2 AND (cardinality($3::TEXT[]) = 0 OR type = ANY($3))
+	ORDER BY created_at DESC, id DESC
+	LIMIT NULLIF($4::BIGINT, 0);
+	`
+
+	rows, err := querier.Query(ctx, query, linkID, activityQuery.Since, types, activityQuery.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("getting activities: %w", err)
+	}
+
+	defer rows.Close()
+
+	var activities []*domain.Activity
+
+	for rows.Next() {
+		var activity domain.Activity
+
+		if err := rows.Scan(
+			&activity.Type, &activity.SourceID, &activity.Title, &activity.CreatedAt, &activity.Body, &activity.UserName, &activity.Labels,
+		); err != nil {
+			return nil, fmt.Errorf("scanning activity: %w", err)
+		}
+
+		activities = append(activities, &activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return activities, nil
+}
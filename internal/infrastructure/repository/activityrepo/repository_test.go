@@ -0,0 +1,158 @@
+package activityrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/repository/activityrepo"
+	"github.com/AFK068/bot/internal/testcontainer"
+)
+
+const (
+	TestConfigPath     = "../../../../config/test.yaml"
+	TestMigrationsPath = "../../../../migrations/changesets"
+)
+
+func setupDB(t *testing.T) (*activityrepo.Repository, *pgxpool.Pool, context.Context) {
+	ctx := context.Background()
+
+	config, err := config.NewConfig(TestConfigPath)
+	assert.NoError(t, err)
+
+	// The test config points to the migrations relative to the link repositories.
+	config.Migration.MigrationsPath = TestMigrationsPath
+
+	testContainer, err := testcontainer.NewPostgresTestcontainerContainer(ctx, config)
+	assert.NoError(t, err)
+
+	dbPool, cleanup, err := testContainer.SetupTestPostgresContainer(ctx)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, cleanup())
+	})
+
+	repo := activityrepo.NewRepository(dbPool)
+
+	return repo, dbPool, ctx
+}
+
+func saveLink(ctx context.Context, t *testing.T, dbPool *pgxpool.Pool, url string) *domain.Link {
+	_, err := dbPool.Exec(ctx, `INSERT INTO links (type, url) VALUES ($1, $2);`, domain.GithubType, url)
+	require.NoError(t, err)
+
+	return &domain.Link{URL: url, Type: domain.GithubType}
+}
+
+func Test_SaveSeenActivities_Success(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	link := saveLink(ctx, t, dbPool, "https://github.com/test/test")
+
+	err := repo.SaveSeenActivities(ctx, link, []*domain.Activity{
+		{SourceID: "issue:1", Revision: "a"},
+		{SourceID: "comment:2"},
+		{Body: "no source"},
+	})
+	require.NoError(t, err)
+
+	revisions, err := repo.GetSeenRevisions(ctx, link, []string{"issue:1", "comment:2", "issue:3"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"issue:1": "a", "comment:2": ""}, revisions)
+}
+
+func Test_SaveSeenActivities_UpdatesRevision(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	link := saveLink(ctx, t, dbPool, "https://github.com/test/test")
+
+	err := repo.SaveSeenActivities(ctx, link, []*domain.Activity{{SourceID: "issue:1", Revision: "a"}})
+	require.NoError(t, err)
+
+	err = repo.SaveSeenActivities(ctx, link, []*domain.Activity{
+		{SourceID: "issue:1", Revision: "b"},
+		{SourceID: "issue:1", Revision: "c"},
+	})
+	require.NoError(t, err)
+
+	revisions, err := repo.GetSeenRevisions(ctx, link, []string{"issue:1"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"issue:1": "c"}, revisions)
+}
+
+func Test_GetSeenRevisions_OtherLink(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	link := saveLink(ctx, t, dbPool, "https://github.com/test/test")
+	other := saveLink(ctx, t, dbPool, "https://github.com/test/other")
+
+	err := repo.SaveSeenActivities(ctx, link, []*domain.Activity{{SourceID: "issue:1", Revision: "a"}})
+	require.NoError(t, err)
+
+	revisions, err := repo.GetSeenRevisions(ctx, other, []string{"issue:1"})
+	require.NoError(t, err)
+	assert.Empty(t, revisions)
+}
+
+func Test_GetSeenRevisions_SeenLongAgo(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	link := saveLink(ctx, t, dbPool, "https://github.com/test/test")
+
+	// An issue relabeled a month after it was reported comes back with the same revision.
+	repo.TimeGetter = func() time.Time { return time.Now().AddDate(0, -1, 0) }
+
+	err := repo.SaveSeenActivities(ctx, link, []*domain.Activity{{SourceID: "issue:1", Revision: "a"}})
+	require.NoError(t, err)
+
+	revisions, err := repo.GetSeenRevisions(ctx, link, []string{"issue:1"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"issue:1": "a"}, revisions)
+}
+
+func Test_GetActivities_Success(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	link := saveLink(ctx, t, dbPool, "https://github.com/test/test")
+	other := saveLink(ctx, t, dbPool, "https://github.com/test/other")
+
+	testTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	err := repo.AddActivities(ctx, link, []*domain.Activity{
+		{Type: domain.GitHubIssue, SourceID: "issue:1", Title: "first", CreatedAt: testTime, Labels: []string{"bug"}},
+		{Type: domain.GitHubComment, SourceID: "comment:2", Title: "second", CreatedAt: testTime.Add(time.Hour)},
+		{Type: domain.GitHubIssue, SourceID: "issue:3", Title: "third", CreatedAt: testTime.Add(2 * time.Hour)},
+	})
+	require.NoError(t, err)
+
+	err = repo.AddActivities(ctx, other, []*domain.Activity{{Type: domain.GitHubIssue, Title: "other", CreatedAt: testTime}})
+	require.NoError(t, err)
+
+	var linkID int64
+	require.NoError(t, dbPool.QueryRow(ctx, `SELECT id FROM links WHERE url = $1;`, link.URL).Scan(&linkID))
+
+	activities, err := repo.GetActivities(ctx, linkID, domain.ActivityQuery{})
+	require.NoError(t, err)
+	require.Len(t, activities, 3)
+	assert.Equal(t, "third", activities[0].Title)
+	assert.Equal(t, []string{"bug"}, activities[2].Labels)
+
+	activities, err = repo.GetActivities(ctx, linkID, domain.ActivityQuery{
+		Since: testTime.Add(time.Minute),
+		Types: []domain.ActivityType{domain.GitHubIssue},
+	})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, "issue:3", activities[0].SourceID)
+
+	activities, err = repo.GetActivities(ctx, linkID, domain.ActivityQuery{Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, activities, 2)
+}
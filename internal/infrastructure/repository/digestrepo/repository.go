@@ -0,0 +1,251 @@
+package digestrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/pkg/txs"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+type timeGetter func() time.Time
+
+type Repository struct {
+	TimeGetter timeGetter
+	db         *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db:         db,
+		TimeGetter: time.Now,
+	}
+}
+
+// GetChatSettings returns the settings of the chat, or the default ones if the chat has not changed them.
+func (r *Repository) GetChatSettings(ctx context.Context, uid int64) (*domain.ChatSettings, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	SELECT tg_chat_id, digest_mode, digest_time, digest_weekday, next_digest_at, timezone, quiet_start, quiet_end
+	FROM chat_settings
+	WHERE tg_chat_id = $1;
+	`
+
+	settings, err := scanSettings(querier.QueryRow(ctx, query, uid))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.DefaultChatSettings(uid), nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("getting chat settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// UpdateChatSettings stores the settings of the chat with the time of its next digest.
+func (r *Repository) UpdateChatSettings(ctx context.Context, settings *domain.ChatSettings) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	INSERT INTO chat_settings (tg_chat_id, digest_mode, digest_time, digest_weekday, next_digest_at, timezone, quiet_start, quiet_end)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	ON CONFLICT (tg_chat_id) DO UPDATE
+	SET digest_mode = $2, digest_time = $3, digest_weekday = $4, next_digest_at = $5,
+		timezone = $6, quiet_start = $7, quiet_end = $8;
+	`
+
+	if _, err := querier.Exec(
+		ctx, query,
+		settings.ChatID, settings.DigestMode, settings.DigestTime, int(settings.DigestWeekday), nullTime(settings.NextDigestAt),
+		settings.Timezone, settings.QuietStart, settings.QuietEnd,
+	); err != nil {
+		return fmt.Errorf("updating chat settings: %w", err)
+	}
+
+	return nil
+}
+
+// GetChatsSettings returns the settings of the given chats by chat, the default ones for the chats that have not changed them.
+func (r *Repository) GetChatsSettings(ctx context.Context, uids []int64) (map[int64]*domain.ChatSettings, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	SELECT tg_chat_id, digest_mode, digest_time, digest_weekday, next_digest_at, timezone, quiet_start, quiet_end
+	FROM chat_settings
+	WHERE tg_chat_id = ANY($1);
+	`
+
+	rows, err := querier.Query(ctx, query, uids)
+	if err != nil {
+		return nil, fmt.Errorf("getting chats settings: %w", err)
+	}
+
+	defer rows.Close()
+
+	chatsSettings := make(map[int64]*domain.ChatSettings, len(uids))
+
+	for rows.Next() {
+		settings, err := scanSettings(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning chat settings: %w", err)
+		}
+
+		chatsSettings[settings.ChatID] = settings
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	for _, uid := range uids {
+		if _, ok := chatsSettings[uid]; !ok {
+			chatsSettings[uid] = domain.DefaultChatSettings(uid)
+		}
+	}
+
+	return chatsSettings, nil
+}
+
+// AddDigestEntry buffers the update for the next digest of the chat. It should be called
+// in the transaction that moves the last check time of the link.
+func (r *Repository) AddDigestEntry(ctx context.Context, uid int64, entry bottypes.DigestEntry) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `INSERT INTO digest_entries (tg_chat_id, payload, created_at) VALUES ($1, $2, $3);`
+
+	if _, err := querier.Exec(ctx, query, uid, entry, r.TimeGetter()); err != nil {
+		return fmt.Errorf("inserting digest entry: %w", err)
+	}
+
+	return nil
+}
+
+// GetDueDigests returns the settings of the chats whose next digest time has passed and locks them,
+// so it must be called in a transaction. Chats locked by another scrapper instance are skipped.
+func (r *Repository) GetDueDigests(ctx context.Context, limit uint64) ([]*domain.ChatSettings, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	SELECT tg_chat_id, digest_mode, digest_time, digest_weekday, next_digest_at, timezone, quiet_start, quiet_end
+	FROM chat_settings
+	WHERE next_digest_at <= $1
+	ORDER BY next_digest_at
+	LIMIT $2
+	FOR UPDATE SKIP LOCKED;
+	`
+
+	rows, err := querier.Query(ctx, query, r.TimeGetter(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("getting due digests: %w", err)
+	}
+
+	defer rows.Close()
+
+	var digests []*domain.ChatSettings
+
+	for rows.Next() {
+		settings, err := scanSettings(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning chat settings: %w", err)
+		}
+
+		digests = append(digests, settings)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return digests, nil
+}
+
+// PopDigestEntries removes the buffered updates of the chat and returns them, oldest first.
+func (r *Repository) PopDigestEntries(ctx context.Context, uid int64) ([]bottypes.DigestEntry, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	WITH popped AS (
+		DELETE FROM digest_entries WHERE tg_chat_id = $1 RETURNING id, payload
+	)
+	SELECT payload FROM popped ORDER BY id;
+	`
+
+	rows, err := querier.Query(ctx, query, uid)
+	if err != nil {
+		return nil, fmt.Errorf("popping digest entries: %w", err)
+	}
+
+	defer rows.Close()
+
+	var entries []bottypes.DigestEntry
+
+	for rows.Next() {
+		var entry bottypes.DigestEntry
+
+		if err := rows.Scan(&entry); err != nil {
+			return nil, fmt.Errorf("scanning digest entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return entries, nil
+}
+
+// UpdateNextDigest stores the next digest time of the chat.
+func (r *Repository) UpdateNextDigest(ctx context.Context, settings *domain.ChatSettings) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `UPDATE chat_settings SET next_digest_at = $1 WHERE tg_chat_id = $2;`
+
+	if _, err := querier.Exec(ctx, query, nullTime(settings.NextDigestAt), settings.ChatID); err != nil {
+		return fmt.Errorf("updating next digest: %w", err)
+	}
+
+	return nil
+}
+
+func scanSettings(row pgx.Row) (*domain.ChatSettings, error) {
+	var (
+		settings   domain.ChatSettings
+		weekday    int
+		nextDigest *time.Time
+	)
+
+	if err := row.Scan(
+		&settings.ChatID, &settings.DigestMode, &settings.DigestTime, &weekday, &nextDigest,
+		&settings.Timezone, &settings.QuietStart, &settings.QuietEnd,
+	); err != nil {
+		return nil, err
+	}
+
+	settings.DigestWeekday = time.Weekday(weekday)
+
+	if nextDigest != nil {
+		settings.NextDigestAt = *nextDigest
+	}
+
+	return &settings, nil
+}
+
+// nullTime stores the zero time as NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -0,0 +1,146 @@
+package digestrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/repository/digestrepo"
+	"github.com/AFK068/bot/internal/testcontainer"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+const (
+	TestConfigPath     = "../../../../config/test.yaml"
+	TestMigrationsPath = "../../../../migrations/changesets"
+)
+
+func setupDB(t *testing.T) (*digestrepo.Repository, *pgxpool.Pool, context.Context) {
+	ctx := context.Background()
+
+	config, err := config.NewConfig(TestConfigPath)
+	assert.NoError(t, err)
+
+	// The test config points to the migrations relative to the link repositories.
+	config.Migration.MigrationsPath = TestMigrationsPath
+
+	testContainer, err := testcontainer.NewPostgresTestcontainerContainer(ctx, config)
+	assert.NoError(t, err)
+
+	dbPool, cleanup, err := testContainer.SetupTestPostgresContainer(ctx)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, cleanup())
+	})
+
+	repo := digestrepo.NewRepository(dbPool)
+
+	return repo, dbPool, ctx
+}
+
+func registerChat(ctx context.Context, t *testing.T, dbPool *pgxpool.Pool, uid int64) {
+	_, err := dbPool.Exec(ctx, `INSERT INTO tg_users (tg_id) VALUES ($1);`, uid)
+	require.NoError(t, err)
+}
+
+func Test_GetChatSettings_Default(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	registerChat(ctx, t, dbPool, 123)
+
+	settings, err := repo.GetChatSettings(ctx, 123)
+	require.NoError(t, err)
+	assert.Equal(t, domain.DefaultChatSettings(123), settings)
+}
+
+func Test_UpdateChatSettings_Success(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	registerChat(ctx, t, dbPool, 123)
+	registerChat(ctx, t, dbPool, 456)
+
+	settings := &domain.ChatSettings{
+		ChatID:        123,
+		DigestMode:    domain.DigestWeekly,
+		DigestTime:    9*time.Hour + 30*time.Minute,
+		DigestWeekday: time.Friday,
+		NextDigestAt:  time.Date(2024, time.January, 5, 9, 30, 0, 0, time.UTC),
+		Timezone:      "Europe/Berlin",
+		QuietStart:    22 * time.Hour,
+		QuietEnd:      7 * time.Hour,
+	}
+
+	require.NoError(t, repo.UpdateChatSettings(ctx, settings))
+
+	stored, err := repo.GetChatSettings(ctx, 123)
+	require.NoError(t, err)
+	assert.Equal(t, settings, stored)
+
+	chatsSettings, err := repo.GetChatsSettings(ctx, []int64{123, 456})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]*domain.ChatSettings{
+		123: settings,
+		456: domain.DefaultChatSettings(456),
+	}, chatsSettings)
+}
+
+func Test_DigestEntries_Success(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	testTime := time.Date(2024, time.January, 5, 9, 30, 0, 0, time.UTC)
+	repo.TimeGetter = func() time.Time {
+		return testTime
+	}
+
+	registerChat(ctx, t, dbPool, 123)
+	registerChat(ctx, t, dbPool, 456)
+
+	require.NoError(t, repo.UpdateChatSettings(ctx, &domain.ChatSettings{
+		ChatID:       123,
+		DigestMode:   domain.DigestHourly,
+		NextDigestAt: testTime.Add(-time.Minute),
+		Timezone:     domain.DefaultTimezone,
+	}))
+	require.NoError(t, repo.UpdateChatSettings(ctx, &domain.ChatSettings{
+		ChatID:       456,
+		DigestMode:   domain.DigestHourly,
+		NextDigestAt: testTime.Add(time.Minute),
+		Timezone:     domain.DefaultTimezone,
+	}))
+
+	first := bottypes.DigestEntry{Url: aws.String("https://github.com/test/test"), Tags: &[]string{"work"}}
+	second := bottypes.DigestEntry{Url: aws.String("https://github.com/test/other")}
+
+	require.NoError(t, repo.AddDigestEntry(ctx, 123, first))
+	require.NoError(t, repo.AddDigestEntry(ctx, 123, second))
+	require.NoError(t, repo.AddDigestEntry(ctx, 456, second))
+
+	due, err := repo.GetDueDigests(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, int64(123), due[0].ChatID)
+
+	entries, err := repo.PopDigestEntries(ctx, 123)
+	require.NoError(t, err)
+	assert.Equal(t, []bottypes.DigestEntry{first, second}, entries)
+
+	entries, err = repo.PopDigestEntries(ctx, 123)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	due[0].NextDigestAt = time.Time{}
+	require.NoError(t, repo.UpdateNextDigest(ctx, due[0]))
+
+	stored, err := repo.GetChatSettings(ctx, 123)
+	require.NoError(t, err)
+	assert.True(t, stored.NextDigestAt.IsZero())
+}
@@ -2,6 +2,7 @@ package inmemoryrepo
 
 import (
 	"context"
+	"slices"
 	"sync"
 	"time"
 
@@ -9,18 +10,14 @@ import (
 	"github.com/AFK068/bot/internal/domain/apperrors"
 )
 
-type timeGetter func() time.Time
-
 type InMemoryChatLinkRepository struct {
-	TimeGetter timeGetter
-	Links      map[int64]map[string]*domain.Link
-	mu         sync.RWMutex
+	Links map[int64]map[string]*domain.Link
+	mu    sync.RWMutex
 }
 
 func NewInMemoryLinkRepository() *InMemoryChatLinkRepository {
 	return &InMemoryChatLinkRepository{
-		Links:      make(map[int64]map[string]*domain.Link),
-		TimeGetter: time.Now,
+		Links: make(map[int64]map[string]*domain.Link),
 	}
 }
 
@@ -80,6 +77,38 @@ func (r *InMemoryChatLinkRepository) DeleteLink(_ context.Context, uid int64, li
 	return nil
 }
 
+func (r *InMemoryChatLinkRepository) MuteLink(_ context.Context, uid int64, link *domain.Link, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.Links[uid][link.URL]
+	if !ok {
+		return &apperrors.LinkIsNotExistError{
+			Message: "Link is not exist",
+		}
+	}
+
+	stored.MutedUntil = until
+
+	return nil
+}
+
+func (r *InMemoryChatLinkRepository) SetLinkUrgent(_ context.Context, uid int64, link *domain.Link, urgent bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.Links[uid][link.URL]
+	if !ok {
+		return &apperrors.LinkIsNotExistError{
+			Message: "Link is not exist",
+		}
+	}
+
+	stored.Urgent = urgent
+
+	return nil
+}
+
 func (r *InMemoryChatLinkRepository) GetListLinks(_ context.Context, uid int64) ([]*domain.Link, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
@@ -118,17 +147,90 @@ func (r *InMemoryChatLinkRepository) GetAllLinks(_ context.Context) ([]*domain.L
 	return allLinks, nil
 }
 
+// GetLinksAfter returns distinct links ordered by URL, starting after the cursor URL.
+func (r *InMemoryChatLinkRepository) GetLinksAfter(_ context.Context, cursor string, limit uint64) ([]*domain.Link, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	distinct := make(map[string]*domain.Link)
+
+	for _, userLinks := range r.Links {
+		for url, link := range userLinks {
+			if url <= cursor {
+				continue
+			}
+
+			if _, ok := distinct[url]; !ok {
+				distinct[url] = &domain.Link{
+					URL:       link.URL,
+					Type:      link.Type,
+					LastCheck: link.LastCheck,
+					Metadata:  link.Metadata,
+				}
+			}
+		}
+	}
+
+	urls := make([]string, 0, len(distinct))
+	for url := range distinct {
+		urls = append(urls, url)
+	}
+
+	slices.Sort(urls)
+
+	if uint64(len(urls)) > limit {
+		urls = urls[:limit]
+	}
+
+	links := make([]*domain.Link, len(urls))
+	for i, url := range urls {
+		links[i] = distinct[url]
+	}
+
+	return links, nil
+}
+
 func (r *InMemoryChatLinkRepository) UpdateLastCheck(_ context.Context, link *domain.Link) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, ok := r.Links[link.UserAddID][link.URL]; !ok {
+	newTime := link.LastCheck
+	updated := false
+
+	for _, userLinks := range r.Links {
+		if subscriber, ok := userLinks[link.URL]; ok {
+			subscriber.LastCheck = newTime
+			updated = true
+		}
+	}
+
+	if !updated {
 		return &apperrors.LinkIsNotExistError{
 			Message: "Link is not exist",
 		}
 	}
 
-	r.Links[link.UserAddID][link.URL].LastCheck = r.TimeGetter()
+	return nil
+}
+
+func (r *InMemoryChatLinkRepository) UpdateLinkMetadata(_ context.Context, link *domain.Link) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	updated := false
+
+	for _, userLinks := range r.Links {
+		if subscriber, ok := userLinks[link.URL]; ok {
+			subscriber.Metadata = link.Metadata
+			updated = true
+		}
+	}
+
+	if !updated {
+		return &apperrors.LinkIsNotExistError{
+			Message: "Link is not exist",
+		}
+	}
 
 	return nil
 }
@@ -147,3 +249,18 @@ func (r *InMemoryChatLinkRepository) GetChatIDsByLink(_ context.Context, link *d
 
 	return chatIDs, nil
 }
+
+func (r *InMemoryChatLinkRepository) GetSubscribersByLink(_ context.Context, link *domain.Link) ([]*domain.Link, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var subscribers []*domain.Link
+
+	for _, userLinks := range r.Links {
+		if subscriber, ok := userLinks[link.URL]; ok {
+			subscribers = append(subscribers, subscriber)
+		}
+	}
+
+	return subscribers, nil
+}
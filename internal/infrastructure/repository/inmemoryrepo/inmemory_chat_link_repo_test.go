@@ -206,11 +206,43 @@ func Test_GetAllLinks(t *testing.T) {
 	}
 }
 
+func Test_GetLinksAfter(t *testing.T) {
+	repo := repository.NewInMemoryLinkRepository()
+	ctx := context.Background()
+
+	for _, chatID := range []int64{1, 2} {
+		err := repo.RegisterChat(ctx, chatID)
+		assert.NoError(t, err)
+	}
+
+	links := []*domain.Link{
+		{URL: "https://github.com/2", UserAddID: 1},
+		{URL: "https://github.com/1", UserAddID: 1},
+		{URL: "https://github.com/1", UserAddID: 2},
+		{URL: "https://stackoverflow.com/1", UserAddID: 2},
+	}
+
+	for _, l := range links {
+		err := repo.SaveLink(ctx, l.UserAddID, l)
+		assert.NoError(t, err)
+	}
+
+	page, err := repo.GetLinksAfter(ctx, "", 2)
+	assert.NoError(t, err)
+	assert.Len(t, page, 2)
+	assert.Equal(t, "https://github.com/1", page[0].URL)
+	assert.Equal(t, "https://github.com/2", page[1].URL)
+
+	page, err = repo.GetLinksAfter(ctx, page[1].URL, 2)
+	assert.NoError(t, err)
+	assert.Len(t, page, 1)
+	assert.Equal(t, "https://stackoverflow.com/1", page[0].URL)
+}
+
 func Test_UpdateLastCheck(t *testing.T) {
 	mockTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
 
 	repo := repository.NewInMemoryLinkRepository()
-	repo.TimeGetter = func() time.Time { return mockTime }
 
 	chatID := int64(1)
 	ctx := context.Background()
@@ -223,6 +255,8 @@ func Test_UpdateLastCheck(t *testing.T) {
 	assert.NoError(t, err)
 
 	t.Run("successful update", func(t *testing.T) {
+		link.LastCheck = mockTime
+
 		err := repo.UpdateLastCheck(ctx, link)
 		assert.NoError(t, err)
 
@@ -231,6 +265,27 @@ func Test_UpdateLastCheck(t *testing.T) {
 		assert.Equal(t, mockTime, updatedLink[0].LastCheck)
 	})
 
+	t.Run("update all subscribers", func(t *testing.T) {
+		otherChatID := int64(2)
+
+		err := repo.RegisterChat(ctx, otherChatID)
+		assert.NoError(t, err)
+
+		err = repo.SaveLink(ctx, otherChatID, &domain.Link{URL: link.URL, UserAddID: otherChatID})
+		assert.NoError(t, err)
+
+		err = repo.UpdateLastCheck(ctx, link)
+		assert.NoError(t, err)
+
+		subscribers, err := repo.GetSubscribersByLink(ctx, link)
+		assert.NoError(t, err)
+		assert.Len(t, subscribers, 2)
+
+		for _, subscriber := range subscribers {
+			assert.Equal(t, mockTime, subscriber.LastCheck)
+		}
+	})
+
 	t.Run("update non-existent link", func(t *testing.T) {
 		err := repo.UpdateLastCheck(ctx, &domain.Link{URL: "invalid"})
 		assert.Error(t, err)
@@ -265,3 +320,32 @@ func Test_GetChatIDsByLink(t *testing.T) {
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, []int64{1, 2}, chatIDs)
 }
+
+func Test_GetSubscribersByLink(t *testing.T) {
+	repo := repository.NewInMemoryLinkRepository()
+	ctx := context.Background()
+
+	err := repo.RegisterChat(ctx, 1)
+	assert.NoError(t, err)
+
+	err = repo.RegisterChat(ctx, 2)
+	assert.NoError(t, err)
+
+	err = repo.SaveLink(ctx, 1, &domain.Link{URL: "https://common.link", UserAddID: 1, Filters: []string{"user:octocat"}})
+	assert.NoError(t, err)
+
+	err = repo.SaveLink(ctx, 2, &domain.Link{URL: "https://common.link", UserAddID: 2})
+	assert.NoError(t, err)
+
+	subscribers, err := repo.GetSubscribersByLink(ctx, &domain.Link{URL: "https://common.link"})
+	assert.NoError(t, err)
+	assert.Len(t, subscribers, 2)
+
+	filters := make(map[int64][]string)
+	for _, subscriber := range subscribers {
+		filters[subscriber.UserAddID] = subscriber.Filters
+	}
+
+	assert.Equal(t, []string{"user:octocat"}, filters[1])
+	assert.Empty(t, filters[2])
+}
@@ -87,10 +87,13 @@ func (r *Repository) DeleteChat(ctx context.Context, uid int64) error {
 func (r *Repository) SaveLink(ctx context.Context, uid int64, link *domain.Link) error {
 	querier := txs.GetQuerier(ctx, r.db)
 
+	// A broken link is checked again once it is added again.
 	query, args, err := squirrel.Insert("links").
-		Columns("url", "type").
-		Values(link.URL, link.Type).
-		Suffix("ON CONFLICT (url) DO NOTHING").
+		Columns("url", "type", "last_checked_at", "metadata").
+		Values(link.URL, link.Type, link.LastCheck, link.Metadata).
+		Suffix(`ON CONFLICT (url) DO UPDATE
+			SET failures = 0, broken = FALSE, next_check_at = EXCLUDED.next_check_at
+			WHERE links.broken`).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -103,9 +106,11 @@ func (r *Repository) SaveLink(ctx context.Context, uid int64, link *domain.Link)
 
 	var linkID int64
 
+	// The lock keeps the link from being deleted by the last subscriber until it is saved.
 	query, args, err = squirrel.Select("id").
 		From("links").
 		Where(squirrel.Eq{"url": link.URL}).
+		Suffix("FOR KEY SHARE").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -117,9 +122,12 @@ func (r *Repository) SaveLink(ctx context.Context, uid int64, link *domain.Link)
 	}
 
 	query, args, err = squirrel.Insert("user_link").
-		Columns("tg_user_id", "link_id", "last_update", "filters", "tags").
-		Values(uid, linkID, link.LastCheck, link.Filters, link.Tags).
-		Suffix("ON CONFLICT (tg_user_id, link_id) DO UPDATE SET last_update = $3, filters = $4, tags = $5").
+		Columns("tg_user_id", "link_id", "last_update", "filters", "tags", "check_interval", "urgent").
+		Values(uid, linkID, link.LastCheck, link.Filters, link.Tags, link.MaxCheckInterval, link.Urgent).
+		Suffix(
+			"ON CONFLICT (tg_user_id, link_id) DO UPDATE " +
+				"SET last_update = $3, filters = $4, tags = $5, check_interval = $6, urgent = $7",
+		).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -153,13 +161,31 @@ func (r *Repository) DeleteLink(ctx context.Context, uid int64, link *domain.Lin
 		return fmt.Errorf("deleting link: %w", err)
 	}
 
+	// A link nobody tracks is deleted with its seen activities and history,
+	// the seen activities of a tracked link are kept for as long as it exists.
+	query, args, err = squirrel.Delete("links").
+		Where(squirrel.Eq{"url": link.URL}).
+		Where("NOT EXISTS (SELECT 1 FROM user_link WHERE user_link.link_id = links.id)").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building delete query: %w", err)
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("deleting untracked link: %w", err)
+	}
+
 	return nil
 }
 
 func (r *Repository) GetListLinks(ctx context.Context, uid int64) ([]*domain.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db)
 
-	query, args, err := squirrel.Select("l.url", "l.type", "ul.last_update", "ul.filters", "ul.tags", "ul.tg_user_id").
+	query, args, err := squirrel.Select(
+		"l.id", "l.url", "l.type", "ul.last_update", "ul.filters", "ul.tags", "ul.tg_user_id",
+		"ul.check_interval", "l.broken", "ul.urgent",
+	).
 		From("user_link ul").
 		Join("links l ON ul.link_id = l.id").
 		Where(squirrel.Eq{"ul.tg_user_id": uid}).
@@ -181,7 +207,10 @@ func (r *Repository) GetListLinks(ctx context.Context, uid int64) ([]*domain.Lin
 	for rows.Next() {
 		var link domain.Link
 
-		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags, &link.UserAddID); err != nil {
+		if err := rows.Scan(
+			&link.ID, &link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags,
+			&link.UserAddID, &link.MaxCheckInterval, &link.Broken, &link.Urgent,
+		); err != nil {
 			return nil, fmt.Errorf("scanning link: %w", err)
 		}
 
@@ -257,20 +286,172 @@ func (r *Repository) GetChatIDsByLink(ctx context.Context, link *domain.Link) ([
 	return chatIDs, nil
 }
 
+// GetSubscribersByLink returns the subscription of every chat tracking the link,
+// including the chat's own filters and tags.
+func (r *Repository) GetSubscribersByLink(ctx context.Context, link *domain.Link) ([]*domain.Link, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query, args, err := squirrel.Select(
+		"l.url", "l.type", "ul.last_update", "ul.filters", "ul.tags", "ul.tg_user_id", "ul.check_interval", "ul.urgent",
+		"ul.muted_until",
+	).
+		From("user_link ul").
+		Join("links l ON ul.link_id = l.id").
+		Where(squirrel.Eq{"l.url": link.URL}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting subscribers by link: %w", err)
+	}
+
+	defer rows.Close()
+
+	var links []*domain.Link
+
+	for rows.Next() {
+		var (
+			link       domain.Link
+			mutedUntil *time.Time
+		)
+
+		if err := rows.Scan(
+			&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags,
+			&link.UserAddID, &link.MaxCheckInterval, &link.Urgent, &mutedUntil,
+		); err != nil {
+			return nil, fmt.Errorf("scanning link: %w", err)
+		}
+
+		if mutedUntil != nil {
+			link.MutedUntil = *mutedUntil
+		}
+
+		links = append(links, &link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return links, nil
+}
+
+// UpdateLastCheck moves the link cursor and the last update time
+// of every subscriber of the link to the last check time of the link.
 func (r *Repository) UpdateLastCheck(ctx context.Context, link *domain.Link) error {
 	querier := txs.GetQuerier(ctx, r.db)
 
-	// Update last check time to current time.
-	newTime := r.TimeGetter()
+	newTime := link.LastCheck
 
-	subQuery := squirrel.Select("id").
-		From("links").
-		Where(squirrel.Eq{"url": link.URL})
+	query, args, err := squirrel.Update("links").
+		Set("last_checked_at", newTime).
+		Where(squirrel.Eq{"url": link.URL}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
 
-	query, args, err := squirrel.Update("user_link").
+	var linkID int64
+
+	if err := querier.QueryRow(ctx, query, args...).Scan(&linkID); err != nil {
+		if err == pgx.ErrNoRows {
+			return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+		}
+
+		return fmt.Errorf("updating link last check: %w", err)
+	}
+
+	query, args, err = squirrel.Update("user_link").
 		Set("last_update", newTime).
-		Where(squirrel.Eq{"tg_user_id": link.UserAddID}).
-		Where(squirrel.Expr("link_id = (?)", subQuery)).
+		Where(squirrel.Eq{"link_id": linkID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("updating subscribers last update: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) MuteLink(ctx context.Context, uid int64, link *domain.Link, until time.Time) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query, args, err := squirrel.Update("user_link").
+		Set("muted_until", nullTime(until)).
+		Where(squirrel.Eq{"tg_user_id": uid}).
+		Where(squirrel.Expr("link_id = (SELECT id FROM links WHERE url = ?)", link.URL)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("muting link: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+	}
+
+	return nil
+}
+
+func (r *Repository) SetLinkUrgent(ctx context.Context, uid int64, link *domain.Link, urgent bool) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query, args, err := squirrel.Update("user_link").
+		Set("urgent", urgent).
+		Where(squirrel.Eq{"tg_user_id": uid}).
+		Where(squirrel.Expr("link_id = (SELECT id FROM links WHERE url = ?)", link.URL)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("updating link urgency: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+	}
+
+	query, args, err = squirrel.Update("links").
+		Set("next_check_at", r.TimeGetter()).
+		Where(squirrel.Eq{"url": link.URL}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := querier.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("scheduling link check: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) UpdateLinkMetadata(ctx context.Context, link *domain.Link) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query, args, err := squirrel.Update("links").
+		Set("metadata", link.Metadata).
+		Where(squirrel.Eq{"url": link.URL}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -279,7 +460,7 @@ func (r *Repository) UpdateLastCheck(ctx context.Context, link *domain.Link) err
 
 	tag, err := querier.Exec(ctx, query, args...)
 	if err != nil {
-		return fmt.Errorf("updating last check: %w", err)
+		return fmt.Errorf("updating link metadata: %w", err)
 	}
 
 	if tag.RowsAffected() == 0 {
@@ -292,7 +473,10 @@ func (r *Repository) UpdateLastCheck(ctx context.Context, link *domain.Link) err
 func (r *Repository) GetLinksByTag(ctx context.Context, uid int64, tag string) ([]*domain.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db)
 
-	query, args, err := squirrel.Select("l.url", "l.type", "ul.last_update", "ul.filters", "ul.tags", "ul.tg_user_id").
+	query, args, err := squirrel.Select(
+		"l.id", "l.url", "l.type", "ul.last_update", "ul.filters", "ul.tags", "ul.tg_user_id",
+		"ul.check_interval", "l.broken", "ul.urgent",
+	).
 		From("user_link ul").
 		Join("links l ON ul.link_id = l.id").
 		Where(squirrel.Eq{"tg_user_id": uid}).
@@ -315,7 +499,10 @@ func (r *Repository) GetLinksByTag(ctx context.Context, uid int64, tag string) (
 	for rows.Next() {
 		var link domain.Link
 
-		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags, &link.UserAddID); err != nil {
+		if err := rows.Scan(
+			&link.ID, &link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags,
+			&link.UserAddID, &link.MaxCheckInterval, &link.Broken, &link.Urgent,
+		); err != nil {
 			return nil, fmt.Errorf("scanning link: %w", err)
 		}
 
@@ -329,14 +516,24 @@ func (r *Repository) GetLinksByTag(ctx context.Context, uid int64, tag string) (
 	return links, nil
 }
 
-func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint64) ([]*domain.Link, error) {
+// GetLinksAfter returns distinct tracked links due for a check and not broken with their own last check time,
+// ordered by URL. The cursor is the URL of the last link of the previous page, or empty for
+// the first page. Subscriber specific fields are left empty.
+func (r *Repository) GetLinksAfter(ctx context.Context, cursor string, limit uint64) ([]*domain.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db)
 
-	query, args, err := squirrel.Select("l.url", "l.type", "ul.last_update", "ul.filters", "ul.tags", "ul.tg_user_id").
+	subscribed := squirrel.Select("1").
 		From("user_link ul").
-		Join("links l ON ul.link_id = l.id").
+		Where("ul.link_id = l.id")
+
+	query, args, err := squirrel.Select("l.url", "l.type", "l.last_checked_at", "l.metadata").
+		From("links l").
+		Where(squirrel.Gt{"l.url": cursor}).
+		Where(squirrel.LtOrEq{"l.next_check_at": r.TimeGetter()}).
+		Where(squirrel.Eq{"l.broken": false}).
+		Where(squirrel.Expr("EXISTS (?)", subscribed)).
+		OrderBy("l.url").
 		Limit(limit).
-		Offset(offset).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
@@ -345,7 +542,7 @@ func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint6
 
 	rows, err := querier.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("getting links pagination: %w", err)
+		return nil, fmt.Errorf("getting links after cursor: %w", err)
 	}
 
 	defer rows.Close()
@@ -355,7 +552,7 @@ func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint6
 	for rows.Next() {
 		var link domain.Link
 
-		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags, &link.UserAddID); err != nil {
+		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Metadata); err != nil {
 			return nil, fmt.Errorf("scanning link: %w", err)
 		}
 
@@ -368,3 +565,109 @@ func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint6
 
 	return links, nil
 }
+
+// ClaimLinks leases the due links that are not broken among the given ones to the caller until now plus the lease.
+// Links locked or leased by another instance are skipped, so concurrent callers get disjoint
+// sets. The claimed links are returned with their current state and the strictest check
+// interval cap of their subscribers.
+func (r *Repository) ClaimLinks(ctx context.Context, links []*domain.Link, lease time.Duration) ([]*domain.Link, error) {
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	querier := txs.GetQuerier(ctx, r.db)
+
+	urls := make([]string, len(links))
+	for i, link := range links {
+		urls[i] = link.URL
+	}
+
+	now := r.TimeGetter()
+
+	due := squirrel.Select("id").
+		From("links").
+		Where(squirrel.Eq{"url": urls}).
+		Where(squirrel.LtOrEq{"next_check_at": now}).
+		Where(squirrel.Eq{"broken": false}).
+		OrderBy("id").
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query, args, err := squirrel.Update("links").
+		Set("next_check_at", now.Add(lease)).
+		Where(squirrel.Expr("id IN (?)", due)).
+		Suffix(`RETURNING url, type, last_checked_at, next_check_at, metadata, check_interval, failures, (
+			SELECT COALESCE(MIN(ul.check_interval), '0') FROM user_link ul
+			WHERE ul.link_id = links.id AND ul.check_interval > '0'
+		)`).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("claiming links: %w", err)
+	}
+
+	defer rows.Close()
+
+	var claimed []*domain.Link
+
+	for rows.Next() {
+		var link domain.Link
+
+		if err := rows.Scan(
+			&link.URL, &link.Type, &link.LastCheck, &link.NextCheck, &link.Metadata,
+			&link.CheckInterval, &link.Failures, &link.MaxCheckInterval,
+		); err != nil {
+			return nil, fmt.Errorf("scanning link: %w", err)
+		}
+
+		claimed = append(claimed, &link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return claimed, nil
+}
+
+// UpdateNextCheck reschedules the link with its check interval and failure state,
+// releasing the claim on it.
+func (r *Repository) UpdateNextCheck(ctx context.Context, link *domain.Link) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query, args, err := squirrel.Update("links").
+		Set("next_check_at", link.NextCheck).
+		Set("check_interval", link.CheckInterval).
+		Set("failures", link.Failures).
+		Set("broken", link.Broken).
+		Where(squirrel.Eq{"url": link.URL}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := querier.Exec(ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("updating link next check: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+	}
+
+	return nil
+}
+
+// nullTime returns NULL for the zero time.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
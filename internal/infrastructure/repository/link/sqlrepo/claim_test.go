@@ -0,0 +1,112 @@
+package sqlrepo_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/application/provider"
+	"github.com/AFK068/bot/internal/application/scrapper"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/internal/infrastructure/repository/activityrepo"
+	"github.com/AFK068/bot/internal/infrastructure/repository/digestrepo"
+	"github.com/AFK068/bot/internal/infrastructure/repository/link/sqlrepo"
+	"github.com/AFK068/bot/internal/infrastructure/repository/outboxrepo"
+	"github.com/AFK068/bot/pkg/client/github"
+	"github.com/AFK068/bot/pkg/txs"
+
+	providerMock "github.com/AFK068/bot/internal/application/provider/mocks"
+)
+
+func Test_ConcurrentInstances_ProcessDisjointLinks(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	uid := int64(12345)
+	require.NoError(t, repo.RegisterChat(ctx, uid))
+
+	// More than one page, so the instances race for several pages.
+	countLinks := 2*int(scrapper.PaginationLimit) + 10
+
+	for i := 0; i < countLinks; i++ {
+		require.NoError(t, repo.SaveLink(ctx, uid, &domain.Link{
+			UserAddID: uid,
+			URL:       fmt.Sprintf("https://github.com/AFK068/%d", i),
+			Type:      domain.GithubType,
+		}))
+	}
+
+	// A crashed instance leaves its claims behind, they expire before the first tick.
+	links, err := repo.GetLinksAfter(ctx, "", scrapper.PaginationLimit)
+	require.NoError(t, err)
+
+	claimed, err := repo.ClaimLinks(ctx, links, time.Second)
+	require.NoError(t, err)
+	require.Len(t, claimed, len(links))
+
+	var (
+		mu     sync.Mutex
+		checks = make(map[string]int)
+	)
+
+	instances := make([]*scrapper.Scrapper, 3)
+
+	for i := range instances {
+		githubClient := providerMock.NewGitHubRepoFetcher(t)
+
+		githubClient.On("RateLimit").Return(github.RateLimit{}).Maybe()
+		githubClient.On("GetRepo", mock.Anything, mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				checks[args.String(1)]++
+			}).
+			Return(&github.Repository{UpdatedAt: time.Now().Add(-1 * time.Hour)}, nil).
+			Maybe()
+
+		registry, err := provider.NewRegistry(provider.NewGitHubProvider(githubClient, logger.NewDiscardLogger()))
+		require.NoError(t, err)
+
+		instances[i], err = scrapper.NewScrapperScheduler(
+			&scrapper.Config{
+				MinCheckInterval: time.Minute,
+				MaxCheckInterval: time.Hour,
+				MaxCheckFailures: scrapper.DefaultMaxCheckFailures,
+			},
+			sqlrepo.NewRepository(dbPool),
+			activityrepo.NewRepository(dbPool),
+			digestrepo.NewRepository(dbPool),
+			outboxrepo.NewRepository(dbPool),
+			txs.NewTxBeginner(dbPool),
+			registry,
+			logger.NewDiscardLogger(),
+		)
+		require.NoError(t, err)
+	}
+
+	for _, instance := range instances {
+		instance.Run(2 * time.Second)
+	}
+
+	// Every instance ticks once.
+	time.Sleep(3 * time.Second)
+
+	for _, instance := range instances {
+		assert.NoError(t, instance.Stop())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	assert.Len(t, checks, countLinks)
+
+	for url, count := range checks {
+		assert.Equal(t, 1, count, "link %s checked by several instances", url)
+	}
+}
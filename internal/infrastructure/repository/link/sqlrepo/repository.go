@@ -66,27 +66,36 @@ func (r *Repository) DeleteChat(ctx context.Context, uid int64) error {
 func (r *Repository) SaveLink(ctx context.Context, uid int64, link *domain.Link) error {
 	querier := txs.GetQuerier(ctx, r.db)
 
-	query := `INSERT INTO links (url, type) VALUES ($1, $2) ON CONFLICT (url) DO NOTHING;`
+	// A broken link is checked again once it is added again.
+	query := `
+	INSERT INTO links (url, type, last_checked_at, metadata) VALUES ($1, $2, $3, $4)
+	ON CONFLICT (url) DO UPDATE
+	SET failures = 0, broken = FALSE, next_check_at = EXCLUDED.next_check_at
+	WHERE links.broken;
+	`
 
-	if _, err := querier.Exec(ctx, query, link.URL, link.Type); err != nil {
+	if _, err := querier.Exec(ctx, query, link.URL, link.Type, link.LastCheck, link.Metadata); err != nil {
 		return fmt.Errorf("inserting link: %w", err)
 	}
 
 	var linkID int64
 
-	query = `SELECT id FROM links WHERE url = $1;`
+	// The lock keeps the link from being deleted by the last subscriber until it is saved.
+	query = `SELECT id FROM links WHERE url = $1 FOR KEY SHARE;`
 	if err := querier.QueryRow(ctx, query, link.URL).Scan(&linkID); err != nil {
 		return fmt.Errorf("getting link id: %w", err)
 	}
 
 	query = `
-	INSERT INTO user_link (tg_user_id, link_id, last_update, filters, tags)
-	VALUES ($1, $2, $3, $4, $5)
+	INSERT INTO user_link (tg_user_id, link_id, last_update, filters, tags, check_interval, urgent)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
 	ON CONFLICT (tg_user_id, link_id) DO UPDATE
-	SET last_update = $3, filters = $4, tags = $5;
+	SET last_update = $3, filters = $4, tags = $5, check_interval = $6, urgent = $7;
 	`
 
-	if _, err := querier.Exec(ctx, query, uid, linkID, link.LastCheck, link.Filters, link.Tags); err != nil {
+	if _, err := querier.Exec(
+		ctx, query, uid, linkID, link.LastCheck, link.Filters, link.Tags, link.MaxCheckInterval, link.Urgent,
+	); err != nil {
 		return fmt.Errorf("inserting user link: %w", err)
 	}
 
@@ -105,6 +114,63 @@ func (r *Repository) DeleteLink(ctx context.Context, uid int64, link *domain.Lin
 		return fmt.Errorf("deleting link: %w", err)
 	}
 
+	// A link nobody tracks is deleted with its seen activities and history,
+	// the seen activities of a tracked link are kept for as long as it exists.
+	query = `
+	DELETE FROM links l
+	WHERE l.url = $1 AND NOT EXISTS (SELECT 1 FROM user_link ul WHERE ul.link_id = l.id);
+	`
+
+	if _, err := querier.Exec(ctx, query, link.URL); err != nil {
+		return fmt.Errorf("deleting untracked link: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) MuteLink(ctx context.Context, uid int64, link *domain.Link, until time.Time) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	UPDATE user_link SET muted_until = $1
+	WHERE tg_user_id = $2 AND link_id = (SELECT id FROM links WHERE url = $3);
+	`
+
+	tag, err := querier.Exec(ctx, query, nullTime(until), uid, link.URL)
+	if err != nil {
+		return fmt.Errorf("muting link: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+	}
+
+	return nil
+}
+
+func (r *Repository) SetLinkUrgent(ctx context.Context, uid int64, link *domain.Link, urgent bool) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	UPDATE user_link SET urgent = $1
+	WHERE tg_user_id = $2 AND link_id = (SELECT id FROM links WHERE url = $3);
+	`
+
+	tag, err := querier.Exec(ctx, query, urgent, uid, link.URL)
+	if err != nil {
+		return fmt.Errorf("updating link urgency: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+	}
+
+	query = `UPDATE links SET next_check_at = $1 WHERE url = $2;`
+
+	if _, err := querier.Exec(ctx, query, r.TimeGetter(), link.URL); err != nil {
+		return fmt.Errorf("scheduling link check: %w", err)
+	}
+
 	return nil
 }
 
@@ -112,7 +178,7 @@ func (r *Repository) GetListLinks(ctx context.Context, uid int64) ([]*domain.Lin
 	querier := txs.GetQuerier(ctx, r.db)
 
 	query := `
-	SELECT l.url, l.type, ul.last_update, ul.filters, ul.tags, ul.tg_user_id
+	SELECT l.id, l.url, l.type, ul.last_update, ul.filters, ul.tags, ul.tg_user_id, ul.check_interval, l.broken, ul.urgent
 	FROM user_link ul
 	JOIN links l ON ul.link_id = l.id
 	WHERE ul.tg_user_id = $1;
@@ -130,7 +196,10 @@ func (r *Repository) GetListLinks(ctx context.Context, uid int64) ([]*domain.Lin
 	for rows.Next() {
 		var link domain.Link
 
-		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags, &link.UserAddID); err != nil {
+		if err := rows.Scan(
+			&link.ID, &link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags,
+			&link.UserAddID, &link.MaxCheckInterval, &link.Broken, &link.Urgent,
+		); err != nil {
 			return nil, fmt.Errorf("scanning link: %w", err)
 		}
 
@@ -193,21 +262,90 @@ func (r *Repository) GetChatIDsByLink(ctx context.Context, link *domain.Link) ([
 	return chatIDs, nil
 }
 
-func (r *Repository) UpdateLastCheck(ctx context.Context, link *domain.Link) error {
+// GetSubscribersByLink returns the subscription of every chat tracking the link,
+// including the chat's own filters and tags.
+func (r *Repository) GetSubscribersByLink(ctx context.Context, link *domain.Link) ([]*domain.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db)
 
 	query := `
-	UPDATE user_link 
-	SET last_update = $1 
-	WHERE tg_user_id = $2 AND link_id = (SELECT id FROM links WHERE url = $3);
+	SELECT l.url, l.type, ul.last_update, ul.filters, ul.tags, ul.tg_user_id, ul.check_interval, ul.urgent, ul.muted_until
+	FROM user_link ul
+	JOIN links l ON ul.link_id = l.id
+	WHERE l.url = $1;
 	`
 
-	// Update last check time to current time.
-	newTime := r.TimeGetter()
+	rows, err := querier.Query(ctx, query, link.URL)
+	if err != nil {
+		return nil, fmt.Errorf("getting subscribers by link: %w", err)
+	}
+
+	defer rows.Close()
+
+	var links []*domain.Link
+
+	for rows.Next() {
+		var (
+			link       domain.Link
+			mutedUntil *time.Time
+		)
+
+		if err := rows.Scan(
+			&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags,
+			&link.UserAddID, &link.MaxCheckInterval, &link.Urgent, &mutedUntil,
+		); err != nil {
+			return nil, fmt.Errorf("scanning link: %w", err)
+		}
+
+		if mutedUntil != nil {
+			link.MutedUntil = *mutedUntil
+		}
+
+		links = append(links, &link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return links, nil
+}
+
+// UpdateLastCheck moves the link cursor and the last update time
+// of every subscriber of the link to the last check time of the link.
+func (r *Repository) UpdateLastCheck(ctx context.Context, link *domain.Link) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `UPDATE links SET last_checked_at = $1 WHERE url = $2 RETURNING id;`
+
+	newTime := link.LastCheck
+
+	var linkID int64
+
+	if err := querier.QueryRow(ctx, query, newTime, link.URL).Scan(&linkID); err != nil {
+		if err == pgx.ErrNoRows {
+			return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+		}
+
+		return fmt.Errorf("updating link last check: %w", err)
+	}
+
+	query = `UPDATE user_link SET last_update = $1 WHERE link_id = $2;`
+
+	if _, err := querier.Exec(ctx, query, newTime, linkID); err != nil {
+		return fmt.Errorf("updating subscribers last update: %w", err)
+	}
+
+	return nil
+}
 
-	tag, err := querier.Exec(ctx, query, newTime, link.UserAddID, link.URL)
+func (r *Repository) UpdateLinkMetadata(ctx context.Context, link *domain.Link) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `UPDATE links SET metadata = $1 WHERE url = $2;`
+
+	tag, err := querier.Exec(ctx, query, link.Metadata, link.URL)
 	if err != nil {
-		return fmt.Errorf("updating last check: %w", err)
+		return fmt.Errorf("updating link metadata: %w", err)
 	}
 
 	if tag.RowsAffected() == 0 {
@@ -221,7 +359,7 @@ func (r *Repository) GetLinksByTag(ctx context.Context, uid int64, tag string) (
 	querier := txs.GetQuerier(ctx, r.db)
 
 	query := ` 
-	SELECT l.url, l.type, ul.last_update, ul.filters, ul.tags, ul.tg_user_id
+	SELECT l.id, l.url, l.type, ul.last_update, ul.filters, ul.tags, ul.tg_user_id, ul.check_interval, l.broken, ul.urgent
 	FROM user_link ul
 	JOIN links l ON ul.link_id = l.id
 	WHERE tg_user_id = $1 AND $2 = ANY(ul.tags);
@@ -239,7 +377,10 @@ func (r *Repository) GetLinksByTag(ctx context.Context, uid int64, tag string) (
 	for rows.Next() {
 		var link domain.Link
 
-		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags, &link.UserAddID); err != nil {
+		if err := rows.Scan(
+			&link.ID, &link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags,
+			&link.UserAddID, &link.MaxCheckInterval, &link.Broken, &link.Urgent,
+		); err != nil {
 			return nil, fmt.Errorf("scanning link: %w", err)
 		}
 
@@ -253,19 +394,23 @@ func (r *Repository) GetLinksByTag(ctx context.Context, uid int64, tag string) (
 	return links, nil
 }
 
-func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint64) ([]*domain.Link, error) {
+// GetLinksAfter returns distinct tracked links due for a check and not broken with their own last check time,
+// ordered by URL. The cursor is the URL of the last link of the previous page, or empty for
+// the first page. Subscriber specific fields are left empty.
+func (r *Repository) GetLinksAfter(ctx context.Context, cursor string, limit uint64) ([]*domain.Link, error) {
 	querier := txs.GetQuerier(ctx, r.db)
 
 	query := `
-	SELECT l.url, l.type, ul.last_update, ul.filters, ul.tags, ul.tg_user_id
-	FROM user_link ul
-	JOIN links l ON ul.link_id = l.id
-	LIMIT $1 OFFSET $2;
+	SELECT l.url, l.type, l.last_checked_at, l.metadata
+	FROM links l
+	WHERE l.url > $1 AND l.next_check_at <= $2 AND NOT l.broken AND EXISTS (SELECT 1 FROM user_link ul WHERE ul.link_id = l.id)
+	ORDER BY l.url
+	LIMIT $3;
 	`
 
-	rows, err := querier.Query(ctx, query, limit, offset)
+	rows, err := querier.Query(ctx, query, cursor, r.TimeGetter(), limit)
 	if err != nil {
-		return nil, fmt.Errorf("getting links pagination: %w", err)
+		return nil, fmt.Errorf("getting links after cursor: %w", err)
 	}
 
 	defer rows.Close()
@@ -275,7 +420,7 @@ func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint6
 	for rows.Next() {
 		var link domain.Link
 
-		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Filters, &link.Tags, &link.UserAddID); err != nil {
+		if err := rows.Scan(&link.URL, &link.Type, &link.LastCheck, &link.Metadata); err != nil {
 			return nil, fmt.Errorf("scanning link: %w", err)
 		}
 
@@ -288,3 +433,92 @@ func (r *Repository) GetLinksPagination(ctx context.Context, offset, limit uint6
 
 	return links, nil
 }
+
+// ClaimLinks leases the due links that are not broken among the given ones to the caller until now plus the lease.
+// Links locked or leased by another instance are skipped, so concurrent callers get disjoint
+// sets. The claimed links are returned with their current state and the strictest check
+// interval cap of their subscribers.
+func (r *Repository) ClaimLinks(ctx context.Context, links []*domain.Link, lease time.Duration) ([]*domain.Link, error) {
+	if len(links) == 0 {
+		return nil, nil
+	}
+
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	UPDATE links SET next_check_at = $1
+	WHERE id IN (
+		SELECT id FROM links
+		WHERE url = ANY($2) AND next_check_at <= $3 AND NOT broken
+		ORDER BY id
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING url, type, last_checked_at, next_check_at, metadata, check_interval, failures, (
+		SELECT COALESCE(MIN(ul.check_interval), '0') FROM user_link ul
+		WHERE ul.link_id = links.id AND ul.check_interval > '0'
+	);
+	`
+
+	urls := make([]string, len(links))
+	for i, link := range links {
+		urls[i] = link.URL
+	}
+
+	now := r.TimeGetter()
+
+	rows, err := querier.Query(ctx, query, now.Add(lease), urls, now)
+	if err != nil {
+		return nil, fmt.Errorf("claiming links: %w", err)
+	}
+
+	defer rows.Close()
+
+	var claimed []*domain.Link
+
+	for rows.Next() {
+		var link domain.Link
+
+		if err := rows.Scan(
+			&link.URL, &link.Type, &link.LastCheck, &link.NextCheck, &link.Metadata,
+			&link.CheckInterval, &link.Failures, &link.MaxCheckInterval,
+		); err != nil {
+			return nil, fmt.Errorf("scanning link: %w", err)
+		}
+
+		claimed = append(claimed, &link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return claimed, nil
+}
+
+// UpdateNextCheck reschedules the link with its check interval and failure state,
+// releasing the claim on it.
+func (r *Repository) UpdateNextCheck(ctx context.Context, link *domain.Link) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `UPDATE links SET next_check_at = $1, check_interval = $2, failures = $3, broken = $4 WHERE url = $5;`
+
+	tag, err := querier.Exec(ctx, query, link.NextCheck, link.CheckInterval, link.Failures, link.Broken, link.URL)
+	if err != nil {
+		return fmt.Errorf("updating link next check: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.LinkIsNotExistError{Message: "Link is not exist"}
+	}
+
+	return nil
+}
+
+// nullTime returns NULL for the zero time.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+
+	return &t
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/AFK068/bot/internal/domain/apperrors"
 	"github.com/AFK068/bot/internal/infrastructure/repository/link/sqlrepo"
 	"github.com/AFK068/bot/internal/testcontainer"
+	"github.com/AFK068/bot/pkg/txs"
 )
 
 const (
@@ -149,6 +150,41 @@ func Test_DeleteLink_Success(t *testing.T) {
 	assert.Equal(t, count, 0)
 }
 
+func Test_DeleteLink_LastSubscriber(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	link := &domain.Link{URL: "https://github.com/AFK068/bot", LastCheck: time.Now()}
+
+	for _, uid := range []int64{1, 2} {
+		assert.NoError(t, repo.RegisterChat(ctx, uid))
+		assert.NoError(t, repo.SaveLink(ctx, uid, link))
+	}
+
+	_, err := dbPool.Exec(ctx, `
+	INSERT INTO seen_activities (link_id, source_id, revision, seen_at)
+	SELECT id, 'issue:1', 'a', NOW() - INTERVAL '30 days' FROM links WHERE url = $1;
+	`, link.URL)
+	assert.NoError(t, err)
+
+	countSeen := func() int {
+		var count int
+		assert.NoError(t, dbPool.QueryRow(ctx, "SELECT COUNT(*) FROM seen_activities").Scan(&count))
+
+		return count
+	}
+
+	// The seen activities are kept, however old, while the link is tracked.
+	assert.NoError(t, repo.DeleteLink(ctx, 1, link))
+	assert.Equal(t, 1, countSeen())
+
+	assert.NoError(t, repo.DeleteLink(ctx, 2, link))
+	assert.Equal(t, 0, countSeen())
+
+	var count int
+	assert.NoError(t, dbPool.QueryRow(ctx, "SELECT COUNT(*) FROM links WHERE url = $1", link.URL).Scan(&count))
+	assert.Equal(t, 0, count)
+}
+
 func Test_GetListLinks_Success(t *testing.T) {
 	repo, _, ctx := setupDB(t)
 
@@ -175,6 +211,7 @@ func Test_GetListLinks_Success(t *testing.T) {
 	links, err := repo.GetListLinks(ctx, uid)
 	assert.NoError(t, err)
 	assert.Len(t, links, 1)
+	assert.NotZero(t, links[0].ID)
 	assert.Equal(t, link.URL, links[0].URL)
 	assert.Equal(t, link.Type, links[0].Type)
 	assert.Equal(t, link.LastCheck, links[0].LastCheck)
@@ -247,6 +284,50 @@ func Test_GetChatIDsByLink_Success(t *testing.T) {
 	assert.Equal(t, uid, chatIDs[0])
 }
 
+func Test_GetSubscribersByLink_Success(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	firstUID := int64(12345)
+	secondUID := int64(54321)
+
+	err := repo.RegisterChat(ctx, firstUID)
+	assert.NoError(t, err)
+
+	err = repo.RegisterChat(ctx, secondUID)
+	assert.NoError(t, err)
+
+	link := &domain.Link{
+		URL:     "https://github.com/AFK068/bot",
+		Type:    domain.GithubType,
+		Filters: []string{"user:octocat"},
+		Tags:    []string{"go"},
+	}
+
+	err = repo.SaveLink(ctx, firstUID, link)
+	assert.NoError(t, err)
+
+	err = repo.SaveLink(ctx, secondUID, &domain.Link{URL: link.URL, Type: domain.GithubType, Urgent: true})
+	assert.NoError(t, err)
+
+	subscribers, err := repo.GetSubscribersByLink(ctx, link)
+	assert.NoError(t, err)
+	assert.Len(t, subscribers, 2)
+
+	filters := make(map[int64][]string)
+	urgent := make(map[int64]bool)
+
+	for _, subscriber := range subscribers {
+		assert.Equal(t, link.URL, subscriber.URL)
+		filters[subscriber.UserAddID] = subscriber.Filters
+		urgent[subscriber.UserAddID] = subscriber.Urgent
+	}
+
+	assert.Equal(t, link.Filters, filters[firstUID])
+	assert.Empty(t, filters[secondUID])
+	assert.False(t, urgent[firstUID])
+	assert.True(t, urgent[secondUID])
+}
+
 func Test_UpdateLastCheck_Success(t *testing.T) {
 	repo, dbPool, ctx := setupDB(t)
 
@@ -257,10 +338,6 @@ func Test_UpdateLastCheck_Success(t *testing.T) {
 
 	testTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
 
-	repo.TimeGetter = func() time.Time {
-		return testTime
-	}
-
 	link := &domain.Link{
 		UserAddID: uid,
 		URL:       "https://github.com/AFK068/bot",
@@ -271,6 +348,8 @@ func Test_UpdateLastCheck_Success(t *testing.T) {
 	err = repo.SaveLink(ctx, uid, link)
 	assert.NoError(t, err)
 
+	link.LastCheck = testTime
+
 	err = repo.UpdateLastCheck(ctx, link)
 	assert.NoError(t, err)
 
@@ -285,6 +364,152 @@ func Test_UpdateLastCheck_Success(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, testTime, lastCheck)
+
+	q = `SELECT last_checked_at FROM links WHERE url = $1;`
+
+	err = dbPool.QueryRow(ctx, q, link.URL).Scan(&lastCheck)
+
+	assert.NoError(t, err)
+	assert.Equal(t, testTime, lastCheck)
+}
+
+func Test_UpdateLastCheck_AllSubscribers(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	uids := []int64{12345, 67890}
+
+	testTime := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	link := &domain.Link{
+		URL:  "https://github.com/AFK068/bot",
+		Type: domain.GithubType,
+	}
+
+	for _, uid := range uids {
+		err := repo.RegisterChat(ctx, uid)
+		assert.NoError(t, err)
+
+		err = repo.SaveLink(ctx, uid, link)
+		assert.NoError(t, err)
+	}
+
+	link.LastCheck = testTime
+
+	err := repo.UpdateLastCheck(ctx, link)
+	assert.NoError(t, err)
+
+	q := `
+	SELECT last_update
+	FROM user_link
+	WHERE tg_user_id = $1 AND link_id = (SELECT id FROM links WHERE url = $2);
+	`
+
+	for _, uid := range uids {
+		var lastCheck time.Time
+		err = dbPool.QueryRow(ctx, q, uid, link.URL).Scan(&lastCheck)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testTime, lastCheck)
+	}
+}
+
+func Test_UpdateLastCheck_NotExist(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	err := repo.UpdateLastCheck(ctx, &domain.Link{URL: "https://github.com/AFK068/bot"})
+	assert.Error(t, err)
+	assert.IsType(t, &apperrors.LinkIsNotExistError{}, err)
+}
+
+func Test_MuteLink_Success(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	link := &domain.Link{URL: "https://github.com/AFK068/bot", Type: domain.GithubType}
+
+	err = repo.SaveLink(ctx, uid, link)
+	assert.NoError(t, err)
+
+	until := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	err = repo.MuteLink(ctx, uid, link, until)
+	assert.NoError(t, err)
+
+	subscribers, err := repo.GetSubscribersByLink(ctx, link)
+	assert.NoError(t, err)
+	assert.Len(t, subscribers, 1)
+	assert.True(t, until.Equal(subscribers[0].MutedUntil))
+
+	// The zero time unmutes the link.
+	err = repo.MuteLink(ctx, uid, link, time.Time{})
+	assert.NoError(t, err)
+
+	subscribers, err = repo.GetSubscribersByLink(ctx, link)
+	assert.NoError(t, err)
+	assert.Len(t, subscribers, 1)
+	assert.True(t, subscribers[0].MutedUntil.IsZero())
+}
+
+func Test_MuteLink_NotExist(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	err = repo.MuteLink(ctx, uid, &domain.Link{URL: "https://github.com/AFK068/bot"}, time.Now())
+	assert.Error(t, err)
+	assert.IsType(t, &apperrors.LinkIsNotExistError{}, err)
+}
+
+func Test_SetLinkUrgent_KeepsLastCheck(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	lastCheck := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	link := &domain.Link{URL: "https://github.com/AFK068/bot", Type: domain.GithubType, LastCheck: lastCheck}
+
+	err = repo.SaveLink(ctx, uid, link)
+	assert.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	repo.TimeGetter = func() time.Time { return now }
+
+	err = repo.SetLinkUrgent(ctx, uid, link, true)
+	assert.NoError(t, err)
+
+	subscribers, err := repo.GetSubscribersByLink(ctx, link)
+	assert.NoError(t, err)
+	assert.Len(t, subscribers, 1)
+	assert.True(t, subscribers[0].Urgent)
+	assert.True(t, lastCheck.Equal(subscribers[0].LastCheck))
+
+	var nextCheck time.Time
+	err = dbPool.QueryRow(ctx, "SELECT next_check_at FROM links WHERE url = $1", link.URL).Scan(&nextCheck)
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(nextCheck))
+}
+
+func Test_SetLinkUrgent_NotExist(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	err = repo.SetLinkUrgent(ctx, uid, &domain.Link{URL: "https://github.com/AFK068/bot"}, true)
+	assert.Error(t, err)
+	assert.IsType(t, &apperrors.LinkIsNotExistError{}, err)
 }
 
 func Test_GetLinksByTag_Success(t *testing.T) {
@@ -322,7 +547,7 @@ func Test_GetLinksByTag_Success(t *testing.T) {
 	assert.Equal(t, link.Tags, links[0].Tags)
 }
 
-func TestGetLinksPagination_Success(t *testing.T) {
+func TestGetLinksAfter_Success(t *testing.T) {
 	repo, _, ctx := setupDB(t)
 
 	uid := int64(12345)
@@ -345,9 +570,10 @@ func TestGetLinksPagination_Success(t *testing.T) {
 	}
 
 	offset := 0
+	cursor := ""
 
 	for offset < countLinks {
-		pagedLinks, err := repo.GetLinksPagination(ctx, uint64(offset), uint64(limit)) //nolint
+		pagedLinks, err := repo.GetLinksAfter(ctx, cursor, uint64(limit)) //nolint
 		assert.NoError(t, err)
 
 		if offset+limit > countLinks {
@@ -359,11 +585,310 @@ func TestGetLinksPagination_Success(t *testing.T) {
 		for i, link := range pagedLinks {
 			expectedURL := fmt.Sprintf("%d", offset+i)
 
-			assert.Equal(t, uid, link.UserAddID)
 			assert.Equal(t, expectedURL, link.URL)
 			assert.Equal(t, domain.GithubType, link.Type)
 		}
 
 		offset += limit
+		cursor = pagedLinks[len(pagedLinks)-1].URL
+	}
+}
+
+func TestGetLinksAfter_LinksChangedBetweenPages(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	for _, url := range []string{"b", "d", "f", "h"} {
+		err = repo.SaveLink(ctx, uid, &domain.Link{UserAddID: uid, URL: url, Type: domain.GithubType})
+		assert.NoError(t, err)
+	}
+
+	pagedLinks, err := repo.GetLinksAfter(ctx, "", 2)
+	assert.NoError(t, err)
+	assert.Len(t, pagedLinks, 2)
+	assert.Equal(t, "d", pagedLinks[1].URL)
+
+	// Links before the cursor are added and removed, the next page does not move.
+	err = repo.SaveLink(ctx, uid, &domain.Link{UserAddID: uid, URL: "a", Type: domain.GithubType})
+	assert.NoError(t, err)
+
+	err = repo.DeleteLink(ctx, uid, &domain.Link{URL: "b"})
+	assert.NoError(t, err)
+
+	pagedLinks, err = repo.GetLinksAfter(ctx, pagedLinks[1].URL, 2)
+	assert.NoError(t, err)
+	assert.Len(t, pagedLinks, 2)
+	assert.Equal(t, "f", pagedLinks[0].URL)
+	assert.Equal(t, "h", pagedLinks[1].URL)
+}
+
+func TestGetLinksAfter_DistinctLinks(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	link := &domain.Link{
+		URL:  "https://github.com/AFK068/bot",
+		Type: domain.GithubType,
+	}
+
+	for _, uid := range []int64{1, 2, 3} {
+		err := repo.RegisterChat(ctx, uid)
+		assert.NoError(t, err)
+
+		err = repo.SaveLink(ctx, uid, link)
+		assert.NoError(t, err)
+	}
+
+	untracked := &domain.Link{
+		URL:  "https://github.com/AFK068/untracked",
+		Type: domain.GithubType,
+	}
+
+	err := repo.SaveLink(ctx, 3, untracked)
+	assert.NoError(t, err)
+
+	err = repo.DeleteLink(ctx, 3, untracked)
+	assert.NoError(t, err)
+
+	pagedLinks, err := repo.GetLinksAfter(ctx, "", 10)
+	assert.NoError(t, err)
+
+	assert.Len(t, pagedLinks, 1)
+	assert.Equal(t, link.URL, pagedLinks[0].URL)
+	assert.Equal(t, domain.GithubType, pagedLinks[0].Type)
+}
+
+func Test_UpdateLinkMetadata_Success(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	link := &domain.Link{
+		UserAddID: uid,
+		URL:       "https://github.com/AFK068/bot",
+		Type:      domain.GithubType,
+	}
+
+	err = repo.SaveLink(ctx, uid, link)
+	assert.NoError(t, err)
+
+	link.Metadata = domain.LinkMetadata{
+		Validators: map[string]domain.Validator{
+			"repo": {ETag: `"abc"`, LastModified: "Wed, 26 Jan 2011 19:14:43 GMT"},
+		},
+	}
+
+	err = repo.UpdateLinkMetadata(ctx, link)
+	assert.NoError(t, err)
+
+	pagedLinks, err := repo.GetLinksAfter(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, pagedLinks, 1)
+	assert.Equal(t, link.Metadata, pagedLinks[0].Metadata)
+
+	err = repo.UpdateLinkMetadata(ctx, &domain.Link{URL: "https://github.com/AFK068/untracked"})
+	assert.Error(t, err)
+	assert.IsType(t, &apperrors.LinkIsNotExistError{}, err)
+}
+
+func saveLinks(ctx context.Context, t *testing.T, repo *sqlrepo.Repository, count int) []*domain.Link {
+	uid := int64(12345)
+
+	err := repo.RegisterChat(ctx, uid)
+	assert.NoError(t, err)
+
+	for i := 0; i < count; i++ {
+		err = repo.SaveLink(ctx, uid, &domain.Link{
+			UserAddID: uid,
+			URL:       fmt.Sprintf("https://github.com/AFK068/%d", i),
+			Type:      domain.GithubType,
+		})
+		assert.NoError(t, err)
+	}
+
+	links, err := repo.GetLinksAfter(ctx, "", uint64(count)) //nolint:gosec // test data
+	assert.NoError(t, err)
+	assert.Len(t, links, count)
+
+	return links
+}
+
+func Test_ClaimLinks_Disjoint(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	links := saveLinks(ctx, t, repo, 4)
+
+	claimed, err := repo.ClaimLinks(ctx, links[:2], time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 2)
+
+	// Rows locked by an open claim are skipped instead of waited for.
+	err = txs.NewTxBeginner(dbPool).WithTransaction(ctx, func(txCtx context.Context) error {
+		locked, err := repo.ClaimLinks(txCtx, links, time.Minute)
+		assert.NoError(t, err)
+		assert.Len(t, locked, 2)
+
+		skipped, err := repo.ClaimLinks(ctx, links, time.Minute)
+		assert.NoError(t, err)
+		assert.Empty(t, skipped)
+
+		return nil
+	})
+	assert.NoError(t, err)
+
+	claimed, err = repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+}
+
+func Test_ClaimLinks_LeaseExpired(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	links := saveLinks(ctx, t, repo, 1)
+
+	claimed, err := repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, links[0].URL, claimed[0].URL)
+	assert.Equal(t, domain.GithubType, claimed[0].Type)
+
+	claimed, err = repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// The instance holding the claim is gone, so the link is claimed again after the lease.
+	repo.TimeGetter = func() time.Time {
+		return time.Now().Add(2 * time.Minute)
+	}
+
+	claimed, err = repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+}
+
+func Test_UpdateNextCheck_Success(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	links := saveLinks(ctx, t, repo, 1)
+
+	claimed, err := repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+
+	claimed[0].NextCheck = time.Now().Add(-time.Second)
+	claimed[0].CheckInterval = 2 * time.Minute
+	claimed[0].Failures = 2
+
+	err = repo.UpdateNextCheck(ctx, claimed[0])
+	assert.NoError(t, err)
+
+	claimed, err = repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, 2*time.Minute, claimed[0].CheckInterval)
+	assert.Equal(t, 2, claimed[0].Failures)
+
+	err = repo.UpdateNextCheck(ctx, &domain.Link{URL: "https://github.com/AFK068/untracked"})
+	assert.Error(t, err)
+	assert.IsType(t, &apperrors.LinkIsNotExistError{}, err)
+}
+
+func Test_UpdateNextCheck_Broken(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	links := saveLinks(ctx, t, repo, 2)
+
+	claimed, err := repo.ClaimLinks(ctx, links[:1], time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+
+	claimed[0].NextCheck = time.Now().Add(-time.Second)
+	claimed[0].Failures = 5
+	claimed[0].Broken = true
+
+	err = repo.UpdateNextCheck(ctx, claimed[0])
+	assert.NoError(t, err)
+
+	// A broken link is no longer checked.
+	due, err := repo.GetLinksAfter(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, links[1].URL, due[0].URL)
+
+	claimed, err = repo.ClaimLinks(ctx, links[:1], time.Minute)
+	assert.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	// Its subscribers see it as broken.
+	listed, err := repo.GetListLinks(ctx, 12345)
+	assert.NoError(t, err)
+	assert.Len(t, listed, 2)
+
+	for _, link := range listed {
+		assert.Equal(t, link.URL == links[0].URL, link.Broken)
+	}
+
+	// Adding it again resets the failures.
+	err = repo.SaveLink(ctx, 12345, &domain.Link{UserAddID: 12345, URL: links[0].URL, Type: domain.GithubType})
+	assert.NoError(t, err)
+
+	claimed, err = repo.ClaimLinks(ctx, links[:1], time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+	assert.Zero(t, claimed[0].Failures)
+}
+
+func Test_GetLinksAfter_OnlyDue(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	links := saveLinks(ctx, t, repo, 2)
+
+	claimed, err := repo.ClaimLinks(ctx, links[:1], time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+
+	due, err := repo.GetLinksAfter(ctx, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, due, 1)
+	assert.Equal(t, links[1].URL, due[0].URL)
+}
+
+func Test_ClaimLinks_SubscriberCheckInterval(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	intervals := map[int64]time.Duration{1: 0, 2: 10 * time.Minute, 3: 5 * time.Minute}
+
+	for uid, interval := range intervals {
+		err := repo.RegisterChat(ctx, uid)
+		assert.NoError(t, err)
+
+		err = repo.SaveLink(ctx, uid, &domain.Link{
+			UserAddID:        uid,
+			URL:              "https://github.com/AFK068/bot",
+			Type:             domain.GithubType,
+			MaxCheckInterval: interval,
+		})
+		assert.NoError(t, err)
 	}
+
+	userLinks, err := repo.GetListLinks(ctx, 2)
+	assert.NoError(t, err)
+	assert.Len(t, userLinks, 1)
+	assert.Equal(t, 10*time.Minute, userLinks[0].MaxCheckInterval)
+
+	links, err := repo.GetLinksAfter(ctx, "", 10)
+	assert.NoError(t, err)
+
+	// Subscribers without a cap are ignored, the strictest cap wins.
+	claimed, err := repo.ClaimLinks(ctx, links, time.Minute)
+	assert.NoError(t, err)
+	assert.Len(t, claimed, 1)
+	assert.Equal(t, 5*time.Minute, claimed[0].MaxCheckInterval)
+	assert.Zero(t, claimed[0].CheckInterval)
 }
@@ -0,0 +1,174 @@
+package outboxrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/pkg/txs"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+type timeGetter func() time.Time
+
+type Repository struct {
+	TimeGetter timeGetter
+	db         *pgxpool.Pool
+}
+
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{
+		db:         db,
+		TimeGetter: time.Now,
+	}
+}
+
+// AddOutboxMessage stores the update for delivery. It should be called in the
+// transaction that moves the last check time of the link.
+func (r *Repository) AddOutboxMessage(ctx context.Context, update bottypes.LinkUpdate) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `INSERT INTO outbox (payload, status, next_attempt_at, created_at) VALUES ($1, $2, $3, $3);`
+
+	if _, err := querier.Exec(ctx, query, update, domain.OutboxStatusPending, r.TimeGetter()); err != nil {
+		return fmt.Errorf("inserting outbox message: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimOutboxMessages claims pending messages whose next attempt time has passed, oldest first.
+// The claim moves their next attempt time to the end of the lease in the statement that selects
+// them, rows claimed by other instances are skipped, so concurrent claims get disjoint messages.
+func (r *Repository) ClaimOutboxMessages(ctx context.Context, limit uint64, lease time.Duration) ([]*domain.OutboxMessage, error) {
+	query := `
+	WITH claimed AS (
+		UPDATE outbox SET next_attempt_at = $1
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = $2 AND next_attempt_at <= $3
+			ORDER BY id
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, payload, status, attempts, next_attempt_at, last_error, created_at
+	)
+	SELECT id, payload, status, attempts, next_attempt_at, last_error, created_at
+	FROM claimed
+	ORDER BY id;
+	`
+
+	now := r.TimeGetter()
+
+	return r.getMessages(ctx, query, now.Add(lease), domain.OutboxStatusPending, now, limit)
+}
+
+func (r *Repository) GetDeadOutboxMessages(ctx context.Context, limit uint64) ([]*domain.OutboxMessage, error) {
+	query := `
+	SELECT id, payload, status, attempts, next_attempt_at, last_error, created_at
+	FROM outbox
+	WHERE status = $1
+	ORDER BY id
+	LIMIT $2;
+	`
+
+	return r.getMessages(ctx, query, domain.OutboxStatusDead, limit)
+}
+
+// UpdateOutboxMessage stores the delivery state of the message after a failed attempt.
+func (r *Repository) UpdateOutboxMessage(ctx context.Context, message *domain.OutboxMessage) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `UPDATE outbox SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4 WHERE id = $5;`
+
+	tag, err := querier.Exec(ctx, query, message.Status, message.Attempts, message.NextAttemptAt, message.LastError, message.ID)
+	if err != nil {
+		return fmt.Errorf("updating outbox message: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.OutboxMessageIsNotExistError{Message: "Outbox message is not exist"}
+	}
+
+	return nil
+}
+
+func (r *Repository) DeleteOutboxMessage(ctx context.Context, id int64) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `DELETE FROM outbox WHERE id = $1;`
+
+	tag, err := querier.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("deleting outbox message: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.OutboxMessageIsNotExistError{Message: "Outbox message is not exist"}
+	}
+
+	return nil
+}
+
+// ReplayOutboxMessage moves a dead message back to pending with a fresh attempt budget.
+func (r *Repository) ReplayOutboxMessage(ctx context.Context, id int64) error {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	query := `
+	UPDATE outbox SET status = $1, attempts = 0, next_attempt_at = $2, last_error = ''
+	WHERE id = $3 AND status = $4;
+	`
+
+	tag, err := querier.Exec(ctx, query, domain.OutboxStatusPending, r.TimeGetter(), id, domain.OutboxStatusDead)
+	if err != nil {
+		return fmt.Errorf("replaying outbox message: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return &apperrors.OutboxMessageIsNotExistError{Message: "Dead outbox message is not exist"}
+	}
+
+	return nil
+}
+
+func (r *Repository) getMessages(ctx context.Context, query string, args ...any) ([]*domain.OutboxMessage, error) {
+	querier := txs.GetQuerier(ctx, r.db)
+
+	rows, err := querier.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("getting outbox messages: %w", err)
+	}
+
+	defer rows.Close()
+
+	var messages []*domain.OutboxMessage
+
+	for rows.Next() {
+		var message domain.OutboxMessage
+
+		if err := rows.Scan(
+			&message.ID,
+			&message.Update,
+			&message.Status,
+			&message.Attempts,
+			&message.NextAttemptAt,
+			&message.LastError,
+			&message.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning outbox message: %w", err)
+		}
+
+		messages = append(messages, &message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating over rows: %w", err)
+	}
+
+	return messages, nil
+}
@@ -0,0 +1,189 @@
+package outboxrepo_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/internal/config"
+	"github.com/AFK068/bot/internal/domain"
+	"github.com/AFK068/bot/internal/domain/apperrors"
+	"github.com/AFK068/bot/internal/infrastructure/repository/outboxrepo"
+	"github.com/AFK068/bot/internal/testcontainer"
+	"github.com/AFK068/bot/pkg/txs"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+const (
+	TestConfigPath     = "../../../../config/test.yaml"
+	TestMigrationsPath = "../../../../migrations/changesets"
+)
+
+func setupDB(t *testing.T) (*outboxrepo.Repository, *pgxpool.Pool, context.Context) {
+	ctx := context.Background()
+
+	config, err := config.NewConfig(TestConfigPath)
+	assert.NoError(t, err)
+
+	// The test config points to the migrations relative to the link repositories.
+	config.Migration.MigrationsPath = TestMigrationsPath
+
+	testContainer, err := testcontainer.NewPostgresTestcontainerContainer(ctx, config)
+	assert.NoError(t, err)
+
+	dbPool, cleanup, err := testContainer.SetupTestPostgresContainer(ctx)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		assert.NoError(t, cleanup())
+	})
+
+	repo := outboxrepo.NewRepository(dbPool)
+
+	return repo, dbPool, ctx
+}
+
+func Test_AddOutboxMessage_Due(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	update := bottypes.LinkUpdate{
+		Url:       aws.String("https://github.com/test/test"),
+		TgChatIds: &[]int64{1, 2},
+	}
+
+	err := repo.AddOutboxMessage(ctx, update)
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	assert.Equal(t, update, messages[0].Update)
+	assert.Equal(t, domain.OutboxStatusPending, messages[0].Status)
+}
+
+func Test_UpdateOutboxMessage_NotDueUntilNextAttempt(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	err := repo.AddOutboxMessage(ctx, bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	message := messages[0]
+	message.Attempts = 1
+	message.NextAttemptAt = time.Now().Add(time.Hour)
+	message.LastError = "bot is unavailable"
+
+	err = repo.UpdateOutboxMessage(ctx, message)
+	require.NoError(t, err)
+
+	messages, err = repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, messages)
+}
+
+func Test_ReplayOutboxMessage_Success(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	err := repo.AddOutboxMessage(ctx, bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	message := messages[0]
+	message.Status = domain.OutboxStatusDead
+	message.Attempts = 8
+
+	err = repo.UpdateOutboxMessage(ctx, message)
+	require.NoError(t, err)
+
+	dead, err := repo.GetDeadOutboxMessages(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+
+	err = repo.ReplayOutboxMessage(ctx, message.ID)
+	require.NoError(t, err)
+
+	messages, err = repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+	assert.Equal(t, 0, messages[0].Attempts)
+}
+
+func Test_ReplayOutboxMessage_NotDead(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	err := repo.AddOutboxMessage(ctx, bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	err = repo.ReplayOutboxMessage(ctx, messages[0].ID)
+	assert.IsType(t, &apperrors.OutboxMessageIsNotExistError{}, err)
+}
+
+func Test_DeleteOutboxMessage_Success(t *testing.T) {
+	repo, _, ctx := setupDB(t)
+
+	err := repo.AddOutboxMessage(ctx, bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+	require.NoError(t, err)
+
+	messages, err := repo.ClaimOutboxMessages(ctx, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, messages, 1)
+
+	err = repo.DeleteOutboxMessage(ctx, messages[0].ID)
+	require.NoError(t, err)
+
+	err = repo.DeleteOutboxMessage(ctx, messages[0].ID)
+	assert.IsType(t, &apperrors.OutboxMessageIsNotExistError{}, err)
+}
+
+func Test_ClaimOutboxMessages_Disjoint(t *testing.T) {
+	repo, dbPool, ctx := setupDB(t)
+
+	for range 4 {
+		err := repo.AddOutboxMessage(ctx, bottypes.LinkUpdate{Url: aws.String("https://github.com/test/test")})
+		require.NoError(t, err)
+	}
+
+	// Rows locked by an open claim are skipped instead of waited for.
+	err := txs.NewTxBeginner(dbPool).WithTransaction(ctx, func(txCtx context.Context) error {
+		locked, err := repo.ClaimOutboxMessages(txCtx, 2, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, locked, 2)
+
+		skipped, err := repo.ClaimOutboxMessages(ctx, 10, time.Minute)
+		require.NoError(t, err)
+		require.Len(t, skipped, 2)
+
+		assert.Less(t, locked[1].ID, skipped[0].ID)
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Claimed messages stay hidden until the end of the lease.
+	claimed, err := repo.ClaimOutboxMessages(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+
+	repo.TimeGetter = func() time.Time { return time.Now().Add(2 * time.Minute) }
+
+	claimed, err = repo.ClaimOutboxMessages(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, claimed, 4)
+}
@@ -2,32 +2,41 @@ package server
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 
 	"github.com/AFK068/bot/internal/application/bot"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 	"github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
 
+	botgrpc "github.com/AFK068/bot/internal/api/grpc/bot/v1"
 	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+	grpcbotapi "github.com/AFK068/bot/internal/infrastructure/grpcapi/botapi"
 )
 
 type BotServer struct {
 	Config  *bot.Config
 	Handler *botapi.BotHandler
 	Echo    *echo.Echo
+	GRPC    *grpc.Server
 	Bot     bot.Service
 }
 
-func NewBotServer(cfg *bot.Config, b bot.Service, hd *botapi.BotHandler) *BotServer {
+func NewBotServer(cfg *bot.Config, b bot.Service, hd *botapi.BotHandler, gs *grpcbotapi.BotServer) *BotServer {
+	grpcServer := grpc.NewServer()
+	botgrpc.RegisterBotServiceServer(grpcServer, gs)
+
 	return &BotServer{
 		Config:  cfg,
 		Handler: hd,
 		Bot:     b,
 		Echo:    echo.New(),
+		GRPC:    grpcServer,
 	}
 }
 
@@ -37,10 +46,21 @@ func (s *BotServer) Start() error {
 	return s.Echo.Start(":" + s.Config.Port)
 }
 
+func (s *BotServer) StartGRPC() error {
+	listener, err := net.Listen("tcp", ":"+s.Config.GRPCPort)
+	if err != nil {
+		return err
+	}
+
+	return s.GRPC.Serve(listener)
+}
+
 func (s *BotServer) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	s.GRPC.GracefulStop()
+
 	return s.Echo.Shutdown(ctx)
 }
 
@@ -55,6 +75,12 @@ func (s *BotServer) RegisterHooks(lc fx.Lifecycle, log *logger.Logger) {
 				}
 			}()
 
+			go func() {
+				if err := s.StartGRPC(); err != nil {
+					log.Error("Failed to start bot grpc server", "error", err)
+				}
+			}()
+
 			return nil
 		},
 		OnStop: func(context.Context) error {
@@ -2,28 +2,37 @@ package server
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"go.uber.org/fx"
+	"google.golang.org/grpc"
 
+	"github.com/AFK068/bot/internal/application/digest"
+	"github.com/AFK068/bot/internal/application/dispatcher"
 	"github.com/AFK068/bot/internal/application/scrapper"
 	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/infrastructure/httpapi/scrapperapi"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 	"github.com/AFK068/bot/internal/middleware"
 
+	scrappergrpc "github.com/AFK068/bot/internal/api/grpc/scrapper/v1"
 	scrappertypes "github.com/AFK068/bot/internal/api/openapi/scrapper/v1"
+	grpcscrapperapi "github.com/AFK068/bot/internal/infrastructure/grpcapi/scrapperapi"
 )
 
 type ScrapperServer struct {
-	Config    *scrapper.Config
-	Handler   *scrapperapi.ScrapperHandler
-	Scheduler *scrapper.Scrapper
-	Echo      *echo.Echo
-	Repo      domain.ChatLinkRepository
-	Logger    *logger.Logger
+	Config     *scrapper.Config
+	Handler    *scrapperapi.ScrapperHandler
+	Scheduler  *scrapper.Scrapper
+	Dispatcher *dispatcher.Dispatcher
+	Digests    *digest.Sender
+	Echo       *echo.Echo
+	GRPC       *grpc.Server
+	Repo       domain.ChatLinkRepository
+	Logger     *logger.Logger
 }
 
 func NewScrapperServer(
@@ -31,15 +40,24 @@ func NewScrapperServer(
 	repo domain.ChatLinkRepository,
 	hd *scrapperapi.ScrapperHandler,
 	sd *scrapper.Scrapper,
+	dp *dispatcher.Dispatcher,
+	ds *digest.Sender,
+	gs *grpcscrapperapi.ScrapperServer,
 	log *logger.Logger,
 ) *ScrapperServer {
+	grpcServer := grpc.NewServer()
+	scrappergrpc.RegisterScrapperServiceServer(grpcServer, gs)
+
 	return &ScrapperServer{
-		Echo:      echo.New(),
-		Config:    cfg,
-		Repo:      repo,
-		Handler:   hd,
-		Scheduler: sd,
-		Logger:    log,
+		Echo:       echo.New(),
+		GRPC:       grpcServer,
+		Config:     cfg,
+		Repo:       repo,
+		Handler:    hd,
+		Scheduler:  sd,
+		Dispatcher: dp,
+		Digests:    ds,
+		Logger:     log,
 	}
 }
 
@@ -49,16 +67,36 @@ func (s *ScrapperServer) Start() error {
 	// Middleware for checking the user authentication.
 	s.Echo.Use(middleware.AuthLinkMiddleware(s.Repo, s.Logger))
 
+	// Middleware for checking the admin token.
+	s.Echo.Use(middleware.AdminTokenMiddleware(s.Config.AdminToken, s.Logger))
+
 	// Run the scrapper.
 	s.Scheduler.Run(scrapper.DefaultJobDuration)
 
+	// Run the outbox dispatcher.
+	s.Dispatcher.Run(dispatcher.DefaultJobDuration)
+
+	// Run the digest sender.
+	s.Digests.Run(digest.DefaultJobDuration)
+
 	return s.Echo.Start(":" + s.Config.Port)
 }
 
+func (s *ScrapperServer) StartGRPC() error {
+	listener, err := net.Listen("tcp", ":"+s.Config.GRPCPort)
+	if err != nil {
+		return err
+	}
+
+	return s.GRPC.Serve(listener)
+}
+
 func (s *ScrapperServer) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
+	s.GRPC.GracefulStop()
+
 	return s.Echo.Shutdown(ctx)
 }
 
@@ -73,6 +111,12 @@ func (s *ScrapperServer) RegisterHooks(lc fx.Lifecycle, log *logger.Logger) {
 				}
 			}()
 
+			go func() {
+				if err := s.StartGRPC(); err != nil {
+					log.Error("Failed to start scrapper grpc server", "error", err)
+				}
+			}()
+
 			return nil
 		},
 		OnStop: func(context.Context) error {
@@ -0,0 +1,108 @@
+package botapi
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+// BatchTopItems is the number of the newest updates listed in the summary of a batch.
+const BatchTopItems = 5
+
+// formatBatch renders a burst of updates of the link as a summary listing the newest of them,
+// and the list of all of them shown on demand. The details are empty when the summary lists every update.
+func formatBatch(link string, entries []bottypes.DigestEntry, location *time.Location) (summary, details string) {
+	entries = newestFirst(entries)
+
+	var message strings.Builder
+
+	fmt.Fprintf(&message, "%s updated in %s\n%s\n", countActivities(entries), linkName(link), link)
+
+	for i, entry := range entries {
+		if i == BatchTopItems {
+			fmt.Fprintf(&message, "\nand %d more", len(entries)-BatchTopItems)
+			break
+		}
+
+		message.WriteString("\n- " + formatDigestEntry(entry, location))
+	}
+
+	if len(entries) <= BatchTopItems {
+		return message.String(), ""
+	}
+
+	var all strings.Builder
+
+	fmt.Fprintf(&all, "All %d updates of %s:\n", len(entries), link)
+
+	for _, entry := range entries {
+		all.WriteString("\n- " + formatDigestEntry(entry, location))
+	}
+
+	return message.String(), all.String()
+}
+
+// newestFirst returns the entries sorted by their creation time, the newest first.
+func newestFirst(entries []bottypes.DigestEntry) []bottypes.DigestEntry {
+	sorted := make([]bottypes.DigestEntry, len(entries))
+	copy(sorted, entries)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return aws.TimeValue(sorted[i].CreatedAt).After(aws.TimeValue(sorted[j].CreatedAt))
+	})
+
+	return sorted
+}
+
+// countActivities counts the entries by type in words, such as "12 PRs and 28 issues".
+func countActivities(entries []bottypes.DigestEntry) string {
+	var (
+		nouns  []string
+		counts = make(map[string]int)
+	)
+
+	for _, entry := range entries {
+		noun := aws.StringValue(entry.Noun)
+		if noun == "" {
+			noun = defaultNoun
+		}
+
+		if counts[noun] == 0 {
+			nouns = append(nouns, noun)
+		}
+
+		counts[noun]++
+	}
+
+	parts := make([]string, len(nouns))
+
+	for i, noun := range nouns {
+		if counts[noun] > 1 {
+			noun += "s"
+		}
+
+		parts[i] = fmt.Sprintf("%d %s", counts[nouns[i]], noun)
+	}
+
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+// linkName returns the owner and name of a GitHub repository, or the link itself.
+func linkName(link string) string {
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Host != "github.com" || strings.Trim(parsed.Path, "/") == "" {
+		return link
+	}
+
+	return strings.Trim(parsed.Path, "/")
+}
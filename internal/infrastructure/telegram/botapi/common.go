@@ -13,12 +13,26 @@ const (
 	ErrInvalidRequestBody = "invalid_request_body"
 	ErrTgChatsIDIsEmpty   = "tg_chats_id_is_empty"
 	ErrLinkIsEmpty        = "link_is_empty"
+	ErrDigestIsEmpty      = "digest_is_empty"
+	ErrBatchIsEmpty       = "batch_is_empty"
 
 	ErrDescriptionInvalidBody      = "Invalid request body"
 	ErrTgChatsIDIsEmptyDescription = "Tg chats id is empty"
 	ErrLinkIsEmptyDescription      = "Link is empty"
+	ErrDigestIsEmptyDescription    = "Digest is empty"
+	ErrBatchIsEmptyDescription     = "Batch is empty"
 )
 
+// InvalidUpdateError is returned for link updates that cannot be delivered to any chat.
+type InvalidUpdateError struct {
+	Code        string
+	Description string
+}
+
+func (e *InvalidUpdateError) Error() string {
+	return e.Description
+}
+
 func SendSuccessResponse(ctx echo.Context, data any) error {
 	return ctx.JSON(http.StatusOK, data)
 }
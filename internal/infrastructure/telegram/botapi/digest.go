@@ -0,0 +1,96 @@
+package botapi
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/AFK068/bot/pkg/tghtml"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+const (
+	// DigestDescriptionLimit is the number of runes of an update description shown in a digest.
+	DigestDescriptionLimit = 100
+	// CreatedAtLayout is the format of update times, which are shown in the timezone of the chat.
+	CreatedAtLayout = "2006-01-02 15:04 MST"
+
+	noTagsSection = "Without tags"
+)
+
+// formatDigest renders the buffered updates as one message grouped by the tags of the links
+// and then by link. Sections and links keep the order of their first update.
+func formatDigest(entries []bottypes.DigestEntry, location *time.Location) string {
+	var (
+		sections []string
+		links    = make(map[string][]string)
+		updates  = make(map[string][]bottypes.DigestEntry)
+	)
+
+	for _, entry := range entries {
+		section := noTagsSection
+		if entry.Tags != nil && len(*entry.Tags) > 0 {
+			section = "Tags: " + strings.Join(*entry.Tags, ", ")
+		}
+
+		if _, ok := links[section]; !ok {
+			sections = append(sections, section)
+		}
+
+		url := aws.StringValue(entry.Url)
+		if !slices.Contains(links[section], url) {
+			links[section] = append(links[section], url)
+		}
+
+		updates[section+"\n"+url] = append(updates[section+"\n"+url], entry)
+	}
+
+	var message strings.Builder
+
+	fmt.Fprintf(&message, "Digest: %d updates", len(entries))
+
+	for _, section := range sections {
+		fmt.Fprintf(&message, "\n\n%s", section)
+
+		for _, url := range links[section] {
+			fmt.Fprintf(&message, "\n%s", url)
+
+			for _, entry := range updates[section+"\n"+url] {
+				message.WriteString("\n- " + formatDigestEntry(entry, location))
+			}
+		}
+	}
+
+	return message.String()
+}
+
+func formatDigestEntry(entry bottypes.DigestEntry, location *time.Location) string {
+	var parts []string
+
+	if entry.Type != nil && *entry.Type != "" {
+		parts = append(parts, "["+*entry.Type+"]")
+	}
+
+	if entry.Description != nil && *entry.Description != "" {
+		description := strings.Join(strings.Fields(tghtml.PlainText(*entry.Description)), " ")
+		if runes := []rune(description); len(runes) > DigestDescriptionLimit {
+			description = string(runes[:DigestDescriptionLimit]) + "..."
+		}
+
+		parts = append(parts, description)
+	}
+
+	if entry.UserName != nil && *entry.UserName != "" {
+		parts = append(parts, "by "+*entry.UserName)
+	}
+
+	if entry.CreatedAt != nil {
+		parts = append(parts, "at "+entry.CreatedAt.In(location).Format(CreatedAtLayout))
+	}
+
+	return strings.Join(parts, " ")
+}
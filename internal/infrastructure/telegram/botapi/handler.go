@@ -1,11 +1,16 @@
 package botapi
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/labstack/echo/v4"
 
 	"github.com/AFK068/bot/internal/application/bot"
+	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 
 	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
@@ -30,39 +35,110 @@ func (h *BotHandler) PostUpdates(ctx echo.Context) error {
 		return SendBadRequestResponse(ctx, ErrInvalidRequestBody, ErrDescriptionInvalidBody)
 	}
 
+	if err := h.HandleLinkUpdate(ctx.Request().Context(), linkUpdate); err != nil {
+		var invalidErr *InvalidUpdateError
+		if errors.As(err, &invalidErr) {
+			return SendBadRequestResponse(ctx, invalidErr.Code, invalidErr.Description)
+		}
+
+		return err
+	}
+
+	h.Logger.Info("Successfully processed PostUpdates request")
+
+	return SendSuccessResponse(ctx, nil)
+}
+
+// HandleLinkUpdate sends the update to every subscribed chat. It serves updates
+// received over HTTP as well as the ones consumed from the message queue.
+func (h *BotHandler) HandleLinkUpdate(_ context.Context, linkUpdate bottypes.LinkUpdate) error {
 	if linkUpdate.TgChatIds == nil || len(*linkUpdate.TgChatIds) == 0 {
 		h.Logger.Warn("TgChatIds is empty")
-		return SendBadRequestResponse(ctx, ErrTgChatsIDIsEmpty, ErrTgChatsIDIsEmptyDescription)
+		return &InvalidUpdateError{Code: ErrTgChatsIDIsEmpty, Description: ErrTgChatsIDIsEmptyDescription}
+	}
+
+	// Times are rendered in the timezone of the chats the update is sent to.
+	location := domain.LoadLocation(aws.StringValue(linkUpdate.Timezone))
+
+	if linkUpdate.Type != nil && *linkUpdate.Type == string(domain.LinkDigest) {
+		return h.sendDigest(linkUpdate, location)
 	}
 
 	if linkUpdate.Url == nil || *linkUpdate.Url == "" {
 		h.Logger.Warn("Url is empty")
-		return SendBadRequestResponse(ctx, ErrLinkIsEmpty, ErrLinkIsEmptyDescription)
+		return &InvalidUpdateError{Code: ErrLinkIsEmpty, Description: ErrLinkIsEmptyDescription}
 	}
 
-	for _, tgChatID := range *linkUpdate.TgChatIds {
-		message := fmt.Sprintf("Link updated: %s", *linkUpdate.Url)
+	if linkUpdate.Type != nil && *linkUpdate.Type == string(domain.LinkBatch) {
+		return h.sendBatch(linkUpdate, location)
+	}
+
+	if linkUpdate.Type != nil && *linkUpdate.Type == string(domain.LinkBroken) {
+		message := fmt.Sprintf("Link is no longer tracked: %s", *linkUpdate.Url)
 		if linkUpdate.Description != nil && *linkUpdate.Description != "" {
-			message = fmt.Sprintf("%s\nDescription: %s", message, *linkUpdate.Description)
+			message = fmt.Sprintf("%s\nReason: %s", message, *linkUpdate.Description)
 		}
 
-		if linkUpdate.UserName != nil && *linkUpdate.UserName != "" {
-			message = fmt.Sprintf("%s\nUpdated by: %s", message, *linkUpdate.UserName)
-		}
+		message += "\nUse /track to add it again or /untrack to remove it."
 
-		if linkUpdate.Type != nil {
-			message = fmt.Sprintf("%s\nType: %s", message, *linkUpdate.Type)
+		for _, tgChatID := range *linkUpdate.TgChatIds {
+			h.Logger.Info("Sending message", "tgChatID", tgChatID, "message", message)
+			h.Bot.SendMessage(tgChatID, message)
 		}
 
-		if linkUpdate.СreatedAt != nil {
-			message = fmt.Sprintf("%s\nCreated at: %s", message, linkUpdate.СreatedAt.Format("2006-01-02 15:04:05"))
-		}
+		return nil
+	}
+
+	message := formatLinkUpdate(linkUpdate, location)
+	actions := bot.LinkActions{
+		LinkID:  aws.Int64Value(linkUpdate.Id),
+		Link:    *linkUpdate.Url,
+		OpenURL: aws.StringValue(linkUpdate.ActivityUrl),
+	}
 
+	for _, tgChatID := range *linkUpdate.TgChatIds {
 		h.Logger.Info("Sending message", "tgChatID", tgChatID, "message", message)
+		h.Bot.SendLinkUpdate(tgChatID, message, actions)
+	}
+
+	return nil
+}
+
+// sendDigest sends the updates buffered for the chats in digest mode as one message.
+func (h *BotHandler) sendDigest(linkUpdate bottypes.LinkUpdate, location *time.Location) error {
+	if linkUpdate.Digest == nil || len(*linkUpdate.Digest) == 0 {
+		h.Logger.Warn("Digest is empty")
+		return &InvalidUpdateError{Code: ErrDigestIsEmpty, Description: ErrDigestIsEmptyDescription}
+	}
+
+	message := formatDigest(*linkUpdate.Digest, location)
+
+	for _, tgChatID := range *linkUpdate.TgChatIds {
+		h.Logger.Info("Sending digest", "tgChatID", tgChatID, "updates", len(*linkUpdate.Digest))
 		h.Bot.SendMessage(tgChatID, message)
 	}
 
-	h.Logger.Info("Successfully processed PostUpdates request")
+	return nil
+}
 
-	return SendSuccessResponse(ctx, nil)
+// sendBatch sends a burst of updates of the link as one summary, with a button listing all of them.
+func (h *BotHandler) sendBatch(linkUpdate bottypes.LinkUpdate, location *time.Location) error {
+	if linkUpdate.Batch == nil || len(*linkUpdate.Batch) == 0 {
+		h.Logger.Warn("Batch is empty")
+		return &InvalidUpdateError{Code: ErrBatchIsEmpty, Description: ErrBatchIsEmptyDescription}
+	}
+
+	summary, details := formatBatch(*linkUpdate.Url, *linkUpdate.Batch, location)
+
+	for _, tgChatID := range *linkUpdate.TgChatIds {
+		h.Logger.Info("Sending batch", "tgChatID", tgChatID, "updates", len(*linkUpdate.Batch))
+
+		if details == "" {
+			h.Bot.SendMessage(tgChatID, summary)
+		} else {
+			h.Bot.SendExpandable(tgChatID, summary, details)
+		}
+	}
+
+	return nil
 }
@@ -2,16 +2,21 @@ package botapi_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/AFK068/bot/internal/application/bot"
+	"github.com/AFK068/bot/internal/domain"
 	"github.com/AFK068/bot/internal/infrastructure/logger"
 	"github.com/AFK068/bot/internal/infrastructure/telegram/botapi"
 
@@ -23,8 +28,12 @@ func Test_PostUpdates_Success(t *testing.T) {
 	botMock := botmocks.NewService(t)
 	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
 
-	botMock.On("SendMessage", int64(123), "Link updated: https://test\nDescription: Test description").Once()
-	botMock.On("SendMessage", int64(456), "Link updated: https://test").Once()
+	actions := bot.LinkActions{Link: "https://test"}
+
+	botMock.On("SendLinkUpdate", int64(123),
+		"🔔 <b>https://test</b>\nUpdate\n<a href=\"https://test\">https://test</a>\n\nTest description", actions,
+	).Once()
+	botMock.On("SendLinkUpdate", int64(456), "🔔 <b>https://test</b>\nUpdate\n<a href=\"https://test\">https://test</a>", actions).Once()
 
 	testCases := []struct {
 		name string
@@ -171,7 +180,9 @@ func Test_PostUpdates_EmptyDescription(t *testing.T) {
 	botMock := botmocks.NewService(t)
 	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
 
-	botMock.On("SendMessage", int64(123), "Link updated: https://test").Once()
+	botMock.On("SendLinkUpdate", int64(123), "🔔 <b>https://test</b>\nUpdate\n<a href=\"https://test\">https://test</a>",
+		bot.LinkActions{Link: "https://test"},
+	).Once()
 
 	reqBody := bottypes.LinkUpdate{
 		TgChatIds: &[]int64{123},
@@ -193,3 +204,231 @@ func Test_PostUpdates_EmptyDescription(t *testing.T) {
 
 	botMock.AssertExpectations(t)
 }
+
+func Test_PostUpdates_LinkBroken(t *testing.T) {
+	botMock := botmocks.NewService(t)
+	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
+
+	botMock.On("SendMessage", int64(123),
+		"Link is no longer tracked: https://test\nReason: the link no longer exists\nUse /track to add it again or /untrack to remove it.",
+	).Once()
+
+	updateType := string(domain.LinkBroken)
+
+	reqBodyBytes, err := json.Marshal(bottypes.LinkUpdate{
+		TgChatIds:   &[]int64{123},
+		Url:         aws.String("https://test"),
+		Description: aws.String("the link no longer exists"),
+		Type:        &updateType,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/updates", bytes.NewReader(reqBodyBytes))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+
+	err = h.PostUpdates(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func Test_HandleLinkUpdate_Digest(t *testing.T) {
+	botMock := botmocks.NewService(t)
+	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
+
+	createdAt := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+
+	botMock.On("SendMessage", int64(123), "Digest: 3 updates\n\n"+
+		"Tags: work\nhttps://github.com/test/test\n"+
+		"- [github_issue] Crash on <start> by alice at 2024-01-03 11:30 CET\n"+
+		"- [github_pull_request] Fix crash\n\n"+
+		"Without tags\nhttps://stackoverflow.com/questions/1\n"+
+		"- [stackoverflow_answer] Use a mutex",
+	).Once()
+
+	err := h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{123},
+		Type:      aws.String(string(domain.LinkDigest)),
+		Timezone:  aws.String("Europe/Berlin"),
+		Digest: &[]bottypes.DigestEntry{
+			{
+				Url:         aws.String("https://github.com/test/test"),
+				Tags:        &[]string{"work"},
+				Type:        aws.String("github_issue"),
+				Description: aws.String("<b>Crash</b>\non &lt;start&gt;"),
+				UserName:    aws.String("alice"),
+				CreatedAt:   aws.Time(createdAt),
+			},
+			{
+				Url:         aws.String("https://stackoverflow.com/questions/1"),
+				Type:        aws.String("stackoverflow_answer"),
+				Description: aws.String("Use a mutex"),
+			},
+			{
+				Url:         aws.String("https://github.com/test/test"),
+				Tags:        &[]string{"work"},
+				Type:        aws.String("github_pull_request"),
+				Description: aws.String("Fix crash"),
+			},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func Test_HandleLinkUpdate_Timezone(t *testing.T) {
+	botMock := botmocks.NewService(t)
+	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
+
+	createdAt := time.Date(2024, time.July, 3, 22, 30, 0, 0, time.UTC)
+
+	actions := bot.LinkActions{Link: "https://test"}
+
+	botMock.On("SendLinkUpdate", int64(123),
+		"🔔 <b>https://test</b>\nUpdate · 2024-07-04 07:30 JST\n<a href=\"https://test\">https://test</a>", actions,
+	).Once()
+	botMock.On("SendLinkUpdate", int64(456),
+		"🔔 <b>https://test</b>\nUpdate · 2024-07-03 22:30 UTC\n<a href=\"https://test\">https://test</a>", actions,
+	).Once()
+
+	err := h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{123},
+		Url:       aws.String("https://test"),
+		СreatedAt: aws.Time(createdAt),
+		Timezone:  aws.String("Asia/Tokyo"),
+	})
+	assert.NoError(t, err)
+
+	// Unknown timezones fall back to UTC.
+	err = h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{456},
+		Url:       aws.String("https://test"),
+		СreatedAt: aws.Time(createdAt),
+		Timezone:  aws.String("Mars/Olympus"),
+	})
+	assert.NoError(t, err)
+}
+
+func Test_HandleLinkUpdate_HTML(t *testing.T) {
+	botMock := botmocks.NewService(t)
+	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
+
+	createdAt := time.Date(2024, time.January, 3, 10, 30, 0, 0, time.UTC)
+
+	botMock.On("SendLinkUpdate", int64(123),
+		"🐛 <b>Crash in &lt;main&gt;</b>\n"+
+			"Issue by alice · 2024-01-03 10:30 UTC\n"+
+			"<a href=\"https://github.com/owner/repo/issues/1\">owner/repo</a>\n\n"+
+			"<b>Fix</b>: a &amp;&amp; b &lt; c!",
+		bot.LinkActions{LinkID: 7, Link: "https://github.com/owner/repo", OpenURL: "https://github.com/owner/repo/issues/1"},
+	).Once()
+
+	err := h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		Id:          aws.Int64(7),
+		TgChatIds:   &[]int64{123},
+		Url:         aws.String("https://github.com/owner/repo"),
+		ActivityUrl: aws.String("https://github.com/owner/repo/issues/1"),
+		Type:        aws.String(string(domain.GitHubIssue)),
+		Emoji:       aws.String("🐛"),
+		Noun:        aws.String("issue"),
+		Title:       aws.String("Crash in <main>"),
+		Description: aws.String("<b>Fix</b>: a && b < c<span>!</span>"),
+		UserName:    aws.String("alice"),
+		СreatedAt:   aws.Time(createdAt),
+	})
+	assert.NoError(t, err)
+}
+
+func Test_HandleLinkUpdate_EmptyDigest(t *testing.T) {
+	h := botapi.NewBotHandler(botmocks.NewService(t), logger.NewDiscardLogger())
+
+	err := h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{123},
+		Type:      aws.String(string(domain.LinkDigest)),
+	})
+
+	var invalidErr *botapi.InvalidUpdateError
+	require.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, botapi.ErrDigestIsEmpty, invalidErr.Code)
+}
+
+func Test_HandleLinkUpdate_Batch(t *testing.T) {
+	botMock := botmocks.NewService(t)
+	h := botapi.NewBotHandler(botMock, logger.NewDiscardLogger())
+
+	createdAt := time.Date(2024, time.January, 3, 10, 0, 0, 0, time.UTC)
+
+	var batch []bottypes.DigestEntry
+
+	for i := range 7 {
+		entryType, noun := domain.GitHubIssue, "issue"
+		if i < 2 {
+			entryType, noun = domain.GitHubPullRequest, "PR"
+		}
+
+		batch = append(batch, bottypes.DigestEntry{
+			Url:         aws.String("https://github.com/owner/repo"),
+			Type:        aws.String(string(entryType)),
+			Noun:        aws.String(noun),
+			Description: aws.String(fmt.Sprintf("Update %d", i)),
+			CreatedAt:   aws.Time(createdAt.Add(time.Duration(i) * time.Minute)),
+		})
+	}
+
+	botMock.On("SendExpandable", int64(123),
+		"5 issues and 2 PRs updated in owner/repo\nhttps://github.com/owner/repo\n\n"+
+			"- [github_issue] Update 6 at 2024-01-03 10:06 UTC\n"+
+			"- [github_issue] Update 5 at 2024-01-03 10:05 UTC\n"+
+			"- [github_issue] Update 4 at 2024-01-03 10:04 UTC\n"+
+			"- [github_issue] Update 3 at 2024-01-03 10:03 UTC\n"+
+			"- [github_issue] Update 2 at 2024-01-03 10:02 UTC\n"+
+			"and 2 more",
+		"All 7 updates of https://github.com/owner/repo:\n\n"+
+			"- [github_issue] Update 6 at 2024-01-03 10:06 UTC\n"+
+			"- [github_issue] Update 5 at 2024-01-03 10:05 UTC\n"+
+			"- [github_issue] Update 4 at 2024-01-03 10:04 UTC\n"+
+			"- [github_issue] Update 3 at 2024-01-03 10:03 UTC\n"+
+			"- [github_issue] Update 2 at 2024-01-03 10:02 UTC\n"+
+			"- [github_pull_request] Update 1 at 2024-01-03 10:01 UTC\n"+
+			"- [github_pull_request] Update 0 at 2024-01-03 10:00 UTC",
+	).Once()
+
+	botMock.On("SendMessage", int64(456),
+		"1 issue and 1 PR updated in owner/repo\nhttps://github.com/owner/repo\n\n"+
+			"- [github_issue] Update 2 at 2024-01-03 10:02 UTC\n"+
+			"- [github_pull_request] Update 1 at 2024-01-03 10:01 UTC",
+	).Once()
+
+	err := h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{123},
+		Type:      aws.String(string(domain.LinkBatch)),
+		Url:       aws.String("https://github.com/owner/repo"),
+		Batch:     &batch,
+	})
+	assert.NoError(t, err)
+
+	small := batch[1:3]
+
+	err = h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{456},
+		Type:      aws.String(string(domain.LinkBatch)),
+		Url:       aws.String("https://github.com/owner/repo"),
+		Batch:     &small,
+	})
+	assert.NoError(t, err)
+}
+
+func Test_HandleLinkUpdate_EmptyBatch(t *testing.T) {
+	h := botapi.NewBotHandler(botmocks.NewService(t), logger.NewDiscardLogger())
+
+	err := h.HandleLinkUpdate(context.Background(), bottypes.LinkUpdate{
+		TgChatIds: &[]int64{123},
+		Type:      aws.String(string(domain.LinkBatch)),
+		Url:       aws.String("https://github.com/owner/repo"),
+	})
+
+	var invalidErr *botapi.InvalidUpdateError
+	require.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, botapi.ErrBatchIsEmpty, invalidErr.Code)
+}
@@ -0,0 +1,69 @@
+package botapi
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/AFK068/bot/pkg/tghtml"
+
+	bottypes "github.com/AFK068/bot/internal/api/openapi/bot/v1"
+)
+
+// defaultEmoji and defaultNoun show the updates of types their provider gives no emoji or noun.
+const (
+	defaultEmoji = "🔔"
+	defaultNoun  = "update"
+)
+
+// formatLinkUpdate renders the update as Telegram HTML: the emoji of its type and its title,
+// the author and the time in the timezone of the chat, a link to the changed object and the description,
+// which is already Telegram HTML rendered by the provider clients.
+func formatLinkUpdate(linkUpdate bottypes.LinkUpdate, location *time.Location) string {
+	link := aws.StringValue(linkUpdate.Url)
+
+	emoji := aws.StringValue(linkUpdate.Emoji)
+	if emoji == "" {
+		emoji = defaultEmoji
+	}
+
+	title := aws.StringValue(linkUpdate.Title)
+	if title == "" {
+		title = linkName(link)
+	}
+
+	var message strings.Builder
+
+	fmt.Fprintf(&message, "%s <b>%s</b>\n", emoji, html.EscapeString(title))
+
+	noun := aws.StringValue(linkUpdate.Noun)
+	if noun == "" {
+		noun = defaultNoun
+	}
+
+	message.WriteString(strings.ToUpper(noun[:1]) + noun[1:])
+
+	if userName := aws.StringValue(linkUpdate.UserName); userName != "" {
+		message.WriteString(" by " + html.EscapeString(userName))
+	}
+
+	if linkUpdate.СreatedAt != nil {
+		message.WriteString(" · " + linkUpdate.СreatedAt.In(location).Format(CreatedAtLayout))
+	}
+
+	activityURL := aws.StringValue(linkUpdate.ActivityUrl)
+	if activityURL == "" {
+		activityURL = link
+	}
+
+	fmt.Fprintf(&message, "\n<a href=\"%s\">%s</a>", html.EscapeString(activityURL), html.EscapeString(linkName(link)))
+
+	if description := aws.StringValue(linkUpdate.Description); description != "" {
+		message.WriteString("\n\n" + tghtml.Sanitize(description))
+	}
+
+	return message.String()
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/AFK068/bot/internal/infrastructure/httpapi/scrapperapi"
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+)
+
+const (
+	AdminPathPrefix  = "/admin"
+	AdminTokenHeader = "X-Admin-Token"
+)
+
+// AdminTokenMiddleware guards the admin endpoints with a static token.
+// The endpoints are disabled when no token is configured.
+func AdminTokenMiddleware(token string, log *logger.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if !strings.HasPrefix(ctx.Path(), AdminPathPrefix) {
+				return next(ctx)
+			}
+
+			received := ctx.Request().Header.Get(AdminTokenHeader)
+			if token == "" || subtle.ConstantTimeCompare([]byte(received), []byte(token)) != 1 {
+				log.Warn("Invalid admin token", "path", ctx.Path())
+				return scrapperapi.SendUnauthorizedResponse(ctx, scrapperapi.ErrInvalidAdminToken, scrapperapi.ErrDescriptionInvalidAdminToken)
+			}
+
+			return next(ctx)
+		}
+	}
+}
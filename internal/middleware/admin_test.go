@@ -0,0 +1,55 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AFK068/bot/internal/infrastructure/logger"
+	"github.com/AFK068/bot/internal/middleware"
+)
+
+func Test_AdminTokenMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		token      string
+		header     string
+		path       string
+		wantCalled bool
+		wantCode   int
+	}{
+		{name: "Valid token", token: "secret", header: "secret", path: "/admin/outbox/dead", wantCalled: true, wantCode: http.StatusOK},
+		{name: "Invalid token", token: "secret", header: "wrong", path: "/admin/outbox/dead", wantCode: http.StatusUnauthorized},
+		{name: "Admin disabled", token: "", header: "", path: "/admin/outbox/dead", wantCode: http.StatusUnauthorized},
+		{name: "Non admin path", token: "secret", path: "/links", wantCalled: true, wantCode: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := middleware.AdminTokenMiddleware(tt.token, logger.NewDiscardLogger())
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, http.NoBody)
+			req.Header.Set(middleware.AdminTokenHeader, tt.header)
+
+			rec := httptest.NewRecorder()
+
+			c := echo.New().NewContext(req, rec)
+			c.SetPath(tt.path)
+
+			called := false
+			nextHandler := func(c echo.Context) error {
+				called = true
+				return c.String(http.StatusOK, "OK")
+			}
+
+			err := mw(nextHandler)(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCalled, called)
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
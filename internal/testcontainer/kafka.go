@@ -0,0 +1,153 @@
+package testcontainer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/segmentio/kafka-go"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+type KafkaTestcontainer struct {
+	testcontainers.Container
+}
+
+const (
+	DefaultKafkaTestContainerImage = "confluentinc/confluent-local:7.5.0"
+
+	kafkaPublicPort  = "9093/tcp"
+	kafkaStartScript = "/usr/sbin/testcontainers_start.sh"
+)
+
+// The broker has to advertise the mapped port, which is known only after the container
+// is started, so the entrypoint waits for the start script copied by the post start hook.
+const kafkaStartScriptTemplate = `#!/bin/bash
+source /etc/confluent/docker/bash-config
+export KAFKA_ADVERTISED_LISTENERS=PLAINTEXT://%s:%d,BROKER://%s:9092
+sed -i '/KAFKA_ZOOKEEPER_CONNECT/d' /etc/confluent/docker/configure
+echo 'kafka-storage format --ignore-formatted -t "$(kafka-storage random-uuid)" -c /etc/kafka/kafka.properties' >> /etc/confluent/docker/configure
+echo '' > /etc/confluent/docker/ensure
+/etc/confluent/docker/configure
+/etc/confluent/docker/launch
+`
+
+func NewKafkaTestcontainer(ctx context.Context) (*KafkaTestcontainer, error) {
+	req := testcontainers.ContainerRequest{
+		Image:        DefaultKafkaTestContainerImage,
+		ExposedPorts: []string{kafkaPublicPort},
+		Env: map[string]string{
+			"KAFKA_LISTENERS":                                "PLAINTEXT://0.0.0.0:9093,BROKER://0.0.0.0:9092,CONTROLLER://0.0.0.0:9094",
+			"KAFKA_LISTENER_SECURITY_PROTOCOL_MAP":           "BROKER:PLAINTEXT,PLAINTEXT:PLAINTEXT,CONTROLLER:PLAINTEXT",
+			"KAFKA_INTER_BROKER_LISTENER_NAME":               "BROKER",
+			"KAFKA_BROKER_ID":                                "1",
+			"KAFKA_NODE_ID":                                  "1",
+			"KAFKA_PROCESS_ROLES":                            "broker,controller",
+			"KAFKA_CONTROLLER_QUORUM_VOTERS":                 "1@localhost:9094",
+			"KAFKA_CONTROLLER_LISTENER_NAMES":                "CONTROLLER",
+			"KAFKA_OFFSETS_TOPIC_REPLICATION_FACTOR":         "1",
+			"KAFKA_OFFSETS_TOPIC_NUM_PARTITIONS":             "1",
+			"KAFKA_TRANSACTION_STATE_LOG_MIN_ISR":            "1",
+			"KAFKA_TRANSACTION_STATE_LOG_REPLICATION_FACTOR": "1",
+			"KAFKA_GROUP_INITIAL_REBALANCE_DELAY_MS":         "0",
+		},
+		Entrypoint: []string{"sh"},
+		Cmd: []string{"-c", fmt.Sprintf(
+			"while [ ! -f %[1]s ]; do sleep 0.1; done; bash %[1]s", kafkaStartScript,
+		)},
+		LifecycleHooks: []testcontainers.ContainerLifecycleHooks{
+			{
+				PostStarts: []testcontainers.ContainerHook{copyKafkaStartScript},
+			},
+		},
+	}
+
+	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error starting Kafka container: %w", err)
+	}
+
+	return &KafkaTestcontainer{
+		Container: container,
+	}, nil
+}
+
+func copyKafkaStartScript(ctx context.Context, c testcontainers.Container) error {
+	host, err := c.Host(ctx)
+	if err != nil {
+		return err
+	}
+
+	port, err := c.MappedPort(ctx, kafkaPublicPort)
+	if err != nil {
+		return err
+	}
+
+	inspect, err := c.Inspect(ctx)
+	if err != nil {
+		return err
+	}
+
+	script := fmt.Sprintf(kafkaStartScriptTemplate, host, port.Int(), inspect.Config.Hostname)
+
+	if err := c.CopyToContainer(ctx, []byte(script), kafkaStartScript, 0o755); err != nil {
+		return fmt.Errorf("copying start script: %w", err)
+	}
+
+	return wait.ForLog(".*Transitioning from RECOVERY to RUNNING.*").AsRegexp().WaitUntilReady(ctx, c)
+}
+
+// Brokers returns the addresses of the broker reachable from the host.
+func (k *KafkaTestcontainer) Brokers(ctx context.Context) ([]string, error) {
+	host, err := k.Host(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	port, err := k.MappedPort(ctx, nat.Port(kafkaPublicPort))
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{net.JoinHostPort(host, strconv.Itoa(port.Int()))}, nil
+}
+
+// CreateTopics creates single partition topics, so readers can join them before anything is published.
+func (k *KafkaTestcontainer) CreateTopics(ctx context.Context, topics ...string) error {
+	brokers, err := k.Brokers(ctx)
+	if err != nil {
+		return err
+	}
+
+	conn, err := kafka.DialContext(ctx, "tcp", brokers[0])
+	if err != nil {
+		return fmt.Errorf("dialing broker: %w", err)
+	}
+	defer conn.Close()
+
+	configs := make([]kafka.TopicConfig, 0, len(topics))
+	for _, topic := range topics {
+		configs = append(configs, kafka.TopicConfig{Topic: topic, NumPartitions: 1, ReplicationFactor: 1})
+	}
+
+	if err := conn.CreateTopics(configs...); err != nil {
+		return fmt.Errorf("creating topics %s: %w", strings.Join(topics, ", "), err)
+	}
+
+	return nil
+}
+
+func (k *KafkaTestcontainer) Stop() error {
+	contextWithTimeout, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	return k.Container.Terminate(contextWithTimeout)
+}
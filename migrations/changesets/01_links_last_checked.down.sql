@@ -0,0 +1 @@
+ALTER TABLE links DROP COLUMN IF EXISTS last_checked_at;
\ No newline at end of file
@@ -0,0 +1,10 @@
+ALTER TABLE links ADD COLUMN last_checked_at TIMESTAMP;
+
+UPDATE links l
+SET last_checked_at = COALESCE(
+    (SELECT MIN(ul.last_update) FROM user_link ul WHERE ul.link_id = l.id),
+    NOW()
+);
+
+ALTER TABLE links ALTER COLUMN last_checked_at SET NOT NULL;
+ALTER TABLE links ALTER COLUMN last_checked_at SET DEFAULT NOW();
@@ -0,0 +1 @@
+ALTER TABLE links ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
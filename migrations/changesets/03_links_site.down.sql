@@ -0,0 +1 @@
+UPDATE links SET metadata = metadata - 'site' WHERE type = 'stackoverflow';
\ No newline at end of file
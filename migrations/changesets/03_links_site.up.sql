@@ -0,0 +1,2 @@
+UPDATE links SET metadata = jsonb_set(metadata, '{site}', to_jsonb(lower(substring(url FROM '^https?://(?:www\.)?([^/:]+)'))))
+WHERE type = 'stackoverflow' AND NOT metadata ? 'site';
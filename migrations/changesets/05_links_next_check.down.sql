@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS links_next_check_at_idx;
+
+ALTER TABLE links DROP COLUMN IF EXISTS next_check_at;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE links ADD COLUMN next_check_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+CREATE INDEX links_next_check_at_idx ON links(next_check_at);
@@ -0,0 +1,3 @@
+ALTER TABLE user_link DROP COLUMN IF EXISTS check_interval;
+
+ALTER TABLE links DROP COLUMN IF EXISTS check_interval;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE links ADD COLUMN check_interval INTERVAL NOT NULL DEFAULT '0';
+
+ALTER TABLE user_link ADD COLUMN check_interval INTERVAL NOT NULL DEFAULT '0';
@@ -0,0 +1,3 @@
+ALTER TABLE links DROP COLUMN IF EXISTS broken;
+
+ALTER TABLE links DROP COLUMN IF EXISTS failures;
\ No newline at end of file
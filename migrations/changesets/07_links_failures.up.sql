@@ -0,0 +1,3 @@
+ALTER TABLE links ADD COLUMN failures INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE links ADD COLUMN broken BOOLEAN NOT NULL DEFAULT FALSE;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS seen_activities;
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE seen_activities (
+    link_id BIGINT NOT NULL REFERENCES links(id) ON DELETE CASCADE,
+    source_id TEXT NOT NULL,
+    revision TEXT NOT NULL DEFAULT '',
+    seen_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (link_id, source_id)
+);
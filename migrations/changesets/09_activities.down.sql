@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS activities_link_id_created_at_idx;
+DROP TABLE IF EXISTS activities;
\ No newline at end of file
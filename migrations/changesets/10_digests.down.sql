@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS digest_entries_tg_chat_id_idx;
+DROP TABLE IF EXISTS digest_entries;
+DROP INDEX IF EXISTS chat_settings_next_digest_at_idx;
+DROP TABLE IF EXISTS chat_settings;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE chat_settings (
+    tg_chat_id BIGINT PRIMARY KEY REFERENCES tg_users(tg_id) ON DELETE CASCADE,
+    digest_mode TEXT NOT NULL DEFAULT 'immediate',
+    digest_time INTERVAL NOT NULL DEFAULT '0',
+    digest_weekday INT NOT NULL DEFAULT 1,
+    next_digest_at TIMESTAMP
+);
+
+CREATE INDEX chat_settings_next_digest_at_idx ON chat_settings(next_digest_at);
+
+CREATE TABLE digest_entries (
+    id BIGSERIAL PRIMARY KEY,
+    tg_chat_id BIGINT NOT NULL REFERENCES tg_users(tg_id) ON DELETE CASCADE,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX digest_entries_tg_chat_id_idx ON digest_entries(tg_chat_id);
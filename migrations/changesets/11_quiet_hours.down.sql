@@ -0,0 +1,5 @@
+ALTER TABLE user_link DROP COLUMN IF EXISTS urgent;
+
+ALTER TABLE chat_settings DROP COLUMN IF EXISTS quiet_end;
+ALTER TABLE chat_settings DROP COLUMN IF EXISTS quiet_start;
+ALTER TABLE chat_settings DROP COLUMN IF EXISTS timezone;
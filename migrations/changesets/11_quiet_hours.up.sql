@@ -0,0 +1,5 @@
+ALTER TABLE chat_settings ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC';
+ALTER TABLE chat_settings ADD COLUMN quiet_start INTERVAL NOT NULL DEFAULT '0';
+ALTER TABLE chat_settings ADD COLUMN quiet_end INTERVAL NOT NULL DEFAULT '0';
+
+ALTER TABLE user_link ADD COLUMN urgent BOOLEAN NOT NULL DEFAULT FALSE;
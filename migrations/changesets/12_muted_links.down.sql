@@ -0,0 +1 @@
+ALTER TABLE user_link DROP COLUMN IF EXISTS muted_until;
@@ -0,0 +1 @@
+ALTER TABLE user_link ADD COLUMN muted_until TIMESTAMP;
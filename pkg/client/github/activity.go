@@ -1,6 +1,12 @@
 package github
 
-import "time"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+)
 
 type ActivityType string
 
@@ -8,22 +14,69 @@ const (
 	ActivityTypePullRequest ActivityType = "PullRequest"
 	ActivityTypeIssue       ActivityType = "Issue"
 	ActivityTypeRepository  ActivityType = "Repository"
+
+	// Activities of a single issue or pull request.
+	ActivityTypeComment       ActivityType = "Comment"
+	ActivityTypeReviewComment ActivityType = "ReviewComment"
+	ActivityTypeReview        ActivityType = "Review"
+	ActivityTypeLabel         ActivityType = "Label"
+	ActivityTypeState         ActivityType = "State"
+	ActivityTypeCheck         ActivityType = "Check"
+
+	// Activities of releases, tags and branches.
+	ActivityTypeRelease ActivityType = "Release"
+	ActivityTypeTag     ActivityType = "Tag"
+	ActivityTypeCommit  ActivityType = "Commit"
 )
 
+// Activity is a change of a tracked GitHub object. ID identifies the object across checks
+// and Revision changes only when its content does, so repeated reports can be told apart
+// from genuine changes. Immutable objects, such as comments and commits, have no revision.
+// URL is the web page of the changed object, empty if it has none.
 type Activity struct {
 	Type      ActivityType
+	ID        string
+	Revision  string
 	Title     string
 	CreatedAt time.Time
 	Body      string
 	UserName  string
+	Labels    []string
+	URL       string
 }
 
-func NewActivity(activityType ActivityType, title string, createdAt time.Time, body, userName string) *Activity {
+func NewActivity(
+	activityType ActivityType,
+	id, revision, title string,
+	createdAt time.Time,
+	body, userName string,
+	labels []string,
+) *Activity {
 	return &Activity{
 		Type:      activityType,
+		ID:        id,
+		Revision:  revision,
 		Title:     title,
 		CreatedAt: createdAt,
 		Body:      body,
 		UserName:  userName,
+		Labels:    labels,
 	}
 }
+
+// withURL sets the web page of the activity.
+func (a *Activity) withURL(url string) *Activity {
+	a.URL = url
+	return a
+}
+
+// sourceID returns the activity ID of the GitHub object of the kind.
+func sourceID(kind string, id any) string {
+	return fmt.Sprintf("%s:%v", kind, id)
+}
+
+// contentRevision returns a short fingerprint of the content parts.
+func contentRevision(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
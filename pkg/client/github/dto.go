@@ -17,12 +17,15 @@ func (r *repositoryDTO) toRepository() *Repository {
 
 // In GitHub terminology, a pull request is included in a request for issues.
 type issueDTO struct {
-	ID          int64     `json:"id"`
-	Title       string    `json:"title"`
-	Body        string    `json:"body"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	CreatedAtAt time.Time `json:"created_at"`
-	User        userDTO   `json:"user"`
+	ID          int64      `json:"id"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	State       string     `json:"state"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	CreatedAtAt time.Time  `json:"created_at"`
+	User        userDTO    `json:"user"`
+	Labels      []labelDTO `json:"labels"`
+	HTMLURL     string     `json:"html_url"`
 
 	// The pull request and the issue are not explicitly separated in the requests,
 	// so if any of these fields are not null it is of this type.
@@ -30,8 +33,22 @@ type issueDTO struct {
 	SubIssueSummary *subIssueSummaryDTO `json:"sub_issue_summary"`
 }
 
+// toIssue converts the issue, its revision covers the title, body and state, but not the labels.
 func (i *issueDTO) toIssue(issueType IssueType) *Issue {
-	return NewIssue(issueType, i.ID, i.Title, i.Body, i.UpdatedAt, i.CreatedAtAt)
+	issue := NewIssue(issueType, i.ID, i.Title, i.Body, i.User.Login, i.labelNames(), i.UpdatedAt, i.CreatedAtAt)
+	issue.Revision = contentRevision(i.Title, i.Body, i.State)
+	issue.URL = i.HTMLURL
+
+	return issue
+}
+
+func (i *issueDTO) labelNames() []string {
+	labels := make([]string, 0, len(i.Labels))
+	for _, label := range i.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	return labels
 }
 
 type ownerDTO struct {
@@ -42,6 +59,10 @@ type userDTO struct {
 	Login string `json:"login"`
 }
 
+type labelDTO struct {
+	Name string `json:"name"`
+}
+
 type pullRequestDTO struct {
 	URL string `json:"url"`
 }
@@ -50,3 +71,112 @@ type subIssueSummaryDTO struct {
 	Total     int64 `json:"total"`
 	Completed int64 `json:"completed"`
 }
+
+// timelineEventDTO is an event of the issue timeline. Only the fields of
+// the commented, reviewed, labeled, unlabeled, closed, reopened and merged events are decoded.
+type timelineEventDTO struct {
+	ID          int64     `json:"id"`
+	Event       string    `json:"event"`
+	Actor       *userDTO  `json:"actor"`
+	User        *userDTO  `json:"user"`
+	Body        string    `json:"body"`
+	State       string    `json:"state"`
+	Label       *labelDTO `json:"label"`
+	CreatedAt   time.Time `json:"created_at"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	HTMLURL     string    `json:"html_url"`
+}
+
+// author returns the login of the event author, comments and reviews
+// have a user instead of an actor.
+func (e *timelineEventDTO) author() string {
+	if e.User != nil {
+		return e.User.Login
+	}
+
+	if e.Actor != nil {
+		return e.Actor.Login
+	}
+
+	return ""
+}
+
+type reviewCommentDTO struct {
+	ID        int64     `json:"id"`
+	Body      string    `json:"body"`
+	Path      string    `json:"path"`
+	User      userDTO   `json:"user"`
+	CreatedAt time.Time `json:"created_at"`
+	HTMLURL   string    `json:"html_url"`
+}
+
+type checkRunsDTO struct {
+	CheckRuns []checkRunDTO `json:"check_runs"`
+}
+
+type checkRunDTO struct {
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	CompletedAt *time.Time `json:"completed_at"`
+	App         *appDTO    `json:"app"`
+	HTMLURL     string     `json:"html_url"`
+}
+
+type appDTO struct {
+	Name string `json:"name"`
+}
+
+type releaseDTO struct {
+	ID          int64      `json:"id"`
+	TagName     string     `json:"tag_name"`
+	Name        string     `json:"name"`
+	Body        string     `json:"body"`
+	Draft       bool       `json:"draft"`
+	Prerelease  bool       `json:"prerelease"`
+	Author      userDTO    `json:"author"`
+	PublishedAt *time.Time `json:"published_at"`
+	HTMLURL     string     `json:"html_url"`
+}
+
+// eventDTO is a repository event, only the payload of CreateEvent is decoded.
+type eventDTO struct {
+	Type      string                `json:"type"`
+	Actor     userDTO               `json:"actor"`
+	Payload   createEventPayloadDTO `json:"payload"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+type createEventPayloadDTO struct {
+	Ref     string `json:"ref"`
+	RefType string `json:"ref_type"`
+}
+
+type commitDTO struct {
+	SHA     string          `json:"sha"`
+	Commit  commitDetailDTO `json:"commit"`
+	Author  *userDTO        `json:"author"`
+	HTMLURL string          `json:"html_url"`
+}
+
+type commitDetailDTO struct {
+	Message   string          `json:"message"`
+	Author    commitAuthorDTO `json:"author"`
+	Committer commitAuthorDTO `json:"committer"`
+}
+
+type commitAuthorDTO struct {
+	Name string    `json:"name"`
+	Date time.Time `json:"date"`
+}
+
+// author returns the GitHub login of the commit author or the git author name
+// if the commit email is not linked to an account.
+func (c *commitDTO) author() string {
+	if c.Author != nil && c.Author.Login != "" {
+		return c.Author.Login
+	}
+
+	return c.Commit.Author.Name
+}
@@ -0,0 +1,33 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrFailedToGetRepository     = errors.New("failed to get repository")
+	ErrFailedToGetIssues         = errors.New("failed to get issues")
+	ErrFailedToGetTimeline       = errors.New("failed to get timeline")
+	ErrFailedToGetReviewComments = errors.New("failed to get review comments")
+	ErrFailedToGetCheckRuns      = errors.New("failed to get check runs")
+	ErrFailedToGetReleases       = errors.New("failed to get releases")
+	ErrFailedToGetEvents         = errors.New("failed to get events")
+	ErrFailedToGetCommits        = errors.New("failed to get commits")
+
+	// ErrNotModified is returned when the conditional request matched the stored validators.
+	ErrNotModified = errors.New("not modified")
+	// ErrNotFound is returned when the resource was deleted or is no longer accessible.
+	ErrNotFound = errors.New("not found")
+)
+
+// RateLimitError is returned when GitHub rejects a request because
+// the rate limit is exhausted. No request should be sent before Reset.
+type RateLimitError struct {
+	Reset time.Time
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("github rate limit exceeded until %s", e.Reset.Format(time.RFC3339))
+}
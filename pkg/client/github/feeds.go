@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/AFK068/bot/pkg/tghtml"
+)
+
+const (
+	validatorKeyReleases = "releases"
+	validatorKeyEvents   = "events"
+	validatorKeyCommits  = "commits"
+
+	eventTypeCreate = "CreateEvent"
+	refTypeTag      = "tag"
+)
+
+// GetReleaseActivity returns the releases published since the last check time.
+// Drafts are skipped until they are published.
+func (c *Client) GetReleaseActivity(
+	ctx context.Context,
+	releasesURL string,
+	lastCheckTime time.Time,
+	validators Validators,
+) ([]*Activity, error) {
+	target, err := parseTarget(releasesURL)
+	if err != nil {
+		return nil, err
+	}
+
+	// Releases are sorted by the creation time, which may be earlier than
+	// the publication time, so the whole first page is checked.
+	pageURL := fmt.Sprintf("%s/repos/%s/%s/releases?per_page=%d", c.BaseURL, target.owner, target.repo, IssuesPerPage)
+
+	var releases []*releaseDTO
+
+	if _, err := c.get(ctx, pageURL, validatorKeyReleases, validators, &releases); err != nil {
+		if errors.Is(err, ErrNotModified) {
+			return nil, nil
+		}
+
+		return nil, wrapError(ErrFailedToGetReleases, err)
+	}
+
+	var activities []*Activity
+
+	for _, release := range releases {
+		if release.Draft || release.PublishedAt == nil || !release.PublishedAt.After(lastCheckTime) {
+			continue
+		}
+
+		title := release.Name
+		if title == "" {
+			title = release.TagName
+		}
+
+		activities = append(activities, NewActivity(
+			ActivityTypeRelease,
+			sourceID("release", release.ID),
+			"",
+			title,
+			*release.PublishedAt,
+			renderBody(release.Body),
+			release.Author.Login,
+			nil,
+		).withURL(release.HTMLURL))
+	}
+
+	return activities, nil
+}
+
+// GetTagActivity returns the tags created since the last check time.
+// Tags have no creation time, so they are taken from the repository events,
+// which cover the last 90 days.
+func (c *Client) GetTagActivity(
+	ctx context.Context,
+	tagsURL string,
+	lastCheckTime time.Time,
+	validators Validators,
+) ([]*Activity, error) {
+	target, err := parseTarget(tagsURL)
+	if err != nil {
+		return nil, err
+	}
+
+	pageURL := fmt.Sprintf("%s/repos/%s/%s/events?per_page=%d", c.BaseURL, target.owner, target.repo, IssuesPerPage)
+	key := validatorKeyEvents
+
+	var activities []*Activity
+
+	for pageURL != "" {
+		var events []*eventDTO
+
+		header, err := c.get(ctx, pageURL, key, validators, &events)
+		if errors.Is(err, ErrNotModified) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, wrapError(ErrFailedToGetEvents, err)
+		}
+
+		// Events are sorted from the newest one.
+		for _, event := range events {
+			if !event.CreatedAt.After(lastCheckTime) {
+				return activities, nil
+			}
+
+			if event.Type == eventTypeCreate && event.Payload.RefType == refTypeTag {
+				activities = append(activities, NewActivity(
+					ActivityTypeTag,
+					sourceID("tag", event.Payload.Ref),
+					"",
+					event.Payload.Ref,
+					event.CreatedAt,
+					fmt.Sprintf("tag %s created", tghtml.Escape(event.Payload.Ref)),
+					event.Actor.Login,
+					nil,
+				))
+			}
+		}
+
+		pageURL = nextPageURL(header)
+		key = ""
+	}
+
+	return activities, nil
+}
+
+// GetCommitActivity returns the commits pushed to the branch since the last check time.
+// The notification contains the commit message, the first line is the title.
+func (c *Client) GetCommitActivity(
+	ctx context.Context,
+	branchURL string,
+	lastCheckTime time.Time,
+	validators Validators,
+) ([]*Activity, error) {
+	target, err := parseTarget(branchURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.kind != LinkKindBranch {
+		return nil, fmt.Errorf("%s is not a branch URL", branchURL)
+	}
+
+	pageURL := fmt.Sprintf(
+		"%s/repos/%s/%s/commits?sha=%s&since=%s&per_page=%d",
+		c.BaseURL, target.owner, target.repo, url.QueryEscape(target.branch),
+		lastCheckTime.UTC().Format(time.RFC3339), IssuesPerPage,
+	)
+	key := validatorKeyCommits
+
+	var activities []*Activity
+
+	for pageURL != "" {
+		var commits []*commitDTO
+
+		header, err := c.get(ctx, pageURL, key, validators, &commits)
+		if errors.Is(err, ErrNotModified) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, wrapError(ErrFailedToGetCommits, err)
+		}
+
+		for _, commit := range commits {
+			if !commit.Commit.Committer.Date.After(lastCheckTime) {
+				continue
+			}
+
+			title, _, _ := strings.Cut(commit.Commit.Message, "\n")
+
+			activities = append(activities, NewActivity(
+				ActivityTypeCommit,
+				sourceID("commit", commit.SHA),
+				"",
+				title,
+				commit.Commit.Committer.Date,
+				tghtml.Truncate(tghtml.Escape(commit.Commit.Message), TrimBodyLimit),
+				commit.author(),
+				nil,
+			).withURL(commit.HTMLURL))
+		}
+
+		pageURL = nextPageURL(header)
+		key = ""
+	}
+
+	return activities, nil
+}
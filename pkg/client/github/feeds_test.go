@@ -0,0 +1,183 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/pkg/client/github"
+)
+
+func Test_GetReleaseActivity_Success(t *testing.T) {
+	lastCheckTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	before := lastCheckTime.Add(-time.Hour).Format(time.RFC3339)
+	after := lastCheckTime.Add(time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/releases", r.URL.Path)
+
+		response := []map[string]interface{}{
+			{"tag_name": "v2.0.0", "draft": true, "published_at": nil},
+			{"tag_name": "v1.1.0", "name": "", "body": "Bug fixes", "author": map[string]string{"login": "bot"}, "published_at": after},
+			{"tag_name": "v1.0.0", "name": "First release", "published_at": before},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	activities, err := client.GetReleaseActivity(context.Background(), "https://github.com/owner/repo/releases", lastCheckTime, nil)
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, github.ActivityTypeRelease, activities[0].Type)
+	assert.Equal(t, "v1.1.0", activities[0].Title)
+	assert.Equal(t, "Bug fixes", activities[0].Body)
+	assert.Equal(t, "bot", activities[0].UserName)
+}
+
+func Test_GetReleaseActivity_NotModified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `"releases"`, r.Header.Get("If-None-Match"))
+		w.WriteHeader(http.StatusNotModified)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	validators := github.Validators{"releases": {ETag: `"releases"`}}
+
+	activities, err := client.GetReleaseActivity(context.Background(), "https://github.com/owner/repo/releases", time.Now(), validators)
+	require.NoError(t, err)
+	assert.Empty(t, activities)
+}
+
+func Test_GetTagActivity_Success(t *testing.T) {
+	lastCheckTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/events", r.URL.Path)
+
+		response := []map[string]interface{}{
+			{
+				"type":       "CreateEvent",
+				"actor":      map[string]string{"login": "alice"},
+				"payload":    map[string]string{"ref": "v1.1.0", "ref_type": "tag"},
+				"created_at": lastCheckTime.Add(2 * time.Hour).Format(time.RFC3339),
+			},
+			{
+				"type":       "CreateEvent",
+				"actor":      map[string]string{"login": "alice"},
+				"payload":    map[string]string{"ref": "feature", "ref_type": "branch"},
+				"created_at": lastCheckTime.Add(time.Hour).Format(time.RFC3339),
+			},
+			{
+				"type":       "PushEvent",
+				"actor":      map[string]string{"login": "bob"},
+				"payload":    map[string]interface{}{"ref": "refs/heads/main", "size": 1},
+				"created_at": lastCheckTime.Add(time.Hour).Format(time.RFC3339),
+			},
+			{
+				"type":       "CreateEvent",
+				"actor":      map[string]string{"login": "alice"},
+				"payload":    map[string]string{"ref": "v1.0.0", "ref_type": "tag"},
+				"created_at": lastCheckTime.Add(-time.Hour).Format(time.RFC3339),
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	activities, err := client.GetTagActivity(context.Background(), "https://github.com/owner/repo/tags", lastCheckTime, nil)
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, github.ActivityTypeTag, activities[0].Type)
+	assert.Equal(t, "v1.1.0", activities[0].Title)
+	assert.Equal(t, "alice", activities[0].UserName)
+}
+
+func Test_GetCommitActivity_Success(t *testing.T) {
+	lastCheckTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	after := lastCheckTime.Add(time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo/commits", r.URL.Path)
+		assert.Equal(t, "feature/login", r.URL.Query().Get("sha"))
+		assert.Equal(t, "2024-01-01T12:00:00Z", r.URL.Query().Get("since"))
+
+		response := []map[string]interface{}{
+			{
+				"sha": "abc",
+				"commit": map[string]interface{}{
+					"message":   "Add login form\n\nCloses #1",
+					"author":    map[string]string{"name": "Alice", "date": after},
+					"committer": map[string]string{"name": "GitHub", "date": after},
+				},
+				"author": map[string]string{"login": "alice"},
+			},
+			{
+				"sha": "def",
+				"commit": map[string]interface{}{
+					"message":   "Initial commit",
+					"author":    map[string]string{"name": "Bob", "date": after},
+					"committer": map[string]string{"name": "Bob", "date": after},
+				},
+				"author": nil,
+			},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	activities, err := client.GetCommitActivity(
+		context.Background(),
+		"https://github.com/owner/repo/tree/feature/login",
+		lastCheckTime,
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, activities, 2)
+
+	assert.Equal(t, github.ActivityTypeCommit, activities[0].Type)
+	assert.Equal(t, "Add login form", activities[0].Title)
+	assert.Equal(t, "Add login form\n\nCloses #1", activities[0].Body)
+	assert.Equal(t, "alice", activities[0].UserName)
+	assert.Equal(t, "Bob", activities[1].UserName)
+}
+
+func Test_GetCommitActivity_RepositoryURL(t *testing.T) {
+	client := github.NewClient("")
+
+	_, err := client.GetCommitActivity(context.Background(), "https://github.com/owner/repo", time.Now(), nil)
+	assert.Error(t, err)
+}
@@ -5,31 +5,52 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+
+	"github.com/AFK068/bot/pkg/tghtml"
 )
 
 const (
 	BaseGitHubAPIURL = "https://api.github.com"
 	TrimBodyLimit    = 200
+
+	IssuesPerPage = 100
+
+	validatorKeyRepo   = "repo"
+	validatorKeyIssues = "issues"
 )
 
 type Client struct {
 	BaseURL string
 	Client  *resty.Client
+
+	timeGetter func() time.Time
+	rateLimit  RateLimit
+	mu         sync.RWMutex
 }
 
-func NewClient() *Client {
+// NewClient creates a GitHub API client. The token may be a personal access token
+// or a GitHub App installation token, an empty token sends unauthenticated requests.
+func NewClient(token string) *Client {
+	client := resty.New().SetTimeout(10 * time.Second)
+
+	if token != "" {
+		client.SetAuthToken(token)
+	}
+
 	return &Client{
-		BaseURL: BaseGitHubAPIURL,
-		Client:  resty.New().SetTimeout(10 * time.Second),
+		BaseURL:    BaseGitHubAPIURL,
+		Client:     client,
+		timeGetter: time.Now,
 	}
 }
 
-func (c *Client) GetRepo(ctx context.Context, questionURL string) (*Repository, error) {
+// GetRepo returns ErrNotModified if the repository did not change since the validators were stored.
+func (c *Client) GetRepo(ctx context.Context, questionURL string, validators Validators) (*Repository, error) {
 	ownerName, repoName, err := getOwnerAndRepo(questionURL)
 	if err != nil {
 		return nil, err
@@ -39,123 +60,181 @@ func (c *Client) GetRepo(ctx context.Context, questionURL string) (*Repository,
 
 	var repoDTO repositoryDTO
 
-	resp, err := c.Client.R().
-		SetContext(ctx).
-		SetResult(&repoDTO).
-		Get(url)
-	if err != nil {
-		return nil, errors.New("failed to get repository")
-	}
-
-	if resp.StatusCode() != http.StatusOK {
-		return nil, errors.New("failed to get repository")
+	if _, err := c.get(ctx, url, validatorKeyRepo, validators, &repoDTO); err != nil {
+		return nil, wrapError(ErrFailedToGetRepository, err)
 	}
 
 	return repoDTO.toRepository(), nil
 }
 
-func (c *Client) GetActivity(ctx context.Context, repository *Repository, lastCheckTime time.Time) ([]*Activity, error) {
+func (c *Client) GetActivity(
+	ctx context.Context,
+	repository *Repository,
+	lastCheckTime time.Time,
+	validators Validators,
+) ([]*Activity, error) {
 	var activities []*Activity
 
 	if repository.UpdatedAt.After(lastCheckTime) {
 		activities = append(activities, NewActivity(
 			ActivityTypeRepository,
+			sourceID("repository", repository.ID),
+			contentRevision(repository.Description),
 			repository.Description,
 			repository.UpdatedAt,
 			"",
 			repository.Owner,
+			nil,
 		))
 	}
 
-	page := 0
-
-	for {
-		page++
-
-		issues, err := c.GetIssuesByPage(ctx, repository.URL, page)
-		if err != nil {
-			return nil, err
-		}
-
-		if len(issues) == 0 {
-			break
-		}
+	issues, err := c.GetIssuesSince(ctx, repository.URL, lastCheckTime, validators)
+	if err != nil && !errors.Is(err, ErrNotModified) {
+		return nil, err
+	}
 
-		for _, issue := range issues {
-			if issue.UpdatedAt.After(lastCheckTime) {
-				activities = append(activities, NewActivity(
-					ActivityType(issue.Type),
-					issue.Title,
-					issue.UpdatedAt,
-					issue.Body,
-					repository.Owner,
-				))
-			}
-		}
+	for _, issue := range issues {
+		activities = append(activities, NewActivity(
+			ActivityType(issue.Type),
+			sourceID("issue", issue.ID),
+			issue.Revision,
+			issue.Title,
+			issue.UpdatedAt,
+			issue.Body,
+			issue.UserName,
+			issue.Labels,
+		).withURL(issue.URL))
 	}
 
 	return activities, nil
 }
 
-func (c *Client) GetIssuesByPage(ctx context.Context, questionURL string, page int) ([]*Issue, error) {
+// GetIssuesSince returns issues and pull requests updated after since, most recently updated first.
+// It follows the Link header of the responses and stops at the first item that is not newer than since.
+// Only the first page is requested conditionally, ErrNotModified is returned if it did not change.
+func (c *Client) GetIssuesSince(ctx context.Context, questionURL string, since time.Time, validators Validators) ([]*Issue, error) {
 	ownerName, repoName, err := getOwnerAndRepo(questionURL)
 	if err != nil {
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/repos/%s/%s/issues?page=%d", c.BaseURL, ownerName, repoName, page)
+	pageURL := fmt.Sprintf(
+		"%s/repos/%s/%s/issues?since=%s&state=all&sort=updated&direction=desc&per_page=%d",
+		c.BaseURL, ownerName, repoName, since.UTC().Format(time.RFC3339), IssuesPerPage,
+	)
 
-	var issues []*issueDTO
+	// Validators are kept for the first page only, the next pages shift with every update.
+	key := validatorKeyIssues
 
-	resp, err := c.Client.R().
-		SetContext(ctx).
-		SetResult(&issues).
-		Get(url)
-	if err != nil {
-		return nil, errors.New("failed to get issues")
-	}
+	var result []*Issue
 
-	if resp.StatusCode() != http.StatusOK {
-		return nil, errors.New("failed to get issues")
-	}
+	for pageURL != "" {
+		var issues []*issueDTO
 
-	var result []*Issue
+		header, err := c.get(ctx, pageURL, key, validators, &issues)
+		if err != nil {
+			return nil, wrapError(ErrFailedToGetIssues, err)
+		}
 
-	for _, issue := range issues {
-		issue.Body = trimBody(issue.Body)
+		for _, issue := range issues {
+			if !issue.UpdatedAt.After(since) {
+				return result, nil
+			}
+
+			issueType := IssueTypeIssue
+			if issue.PullRequest != nil {
+				issueType = IssueTypePullRequest
+			}
+
+			// The revision is taken from the whole body, so the body is trimmed after the conversion.
+			converted := issue.toIssue(issueType)
+			converted.Body = renderBody(converted.Body)
 
-		if issue.PullRequest != nil {
-			result = append(result, issue.toIssue(IssueTypePullRequest))
-		} else {
-			result = append(result, issue.toIssue(IssueTypeIssue))
+			result = append(result, converted)
 		}
+
+		pageURL = nextPageURL(header)
+		key = ""
 	}
 
 	return result, nil
 }
 
-func trimBody(body string) string {
-	if len(body) > TrimBodyLimit {
-		return body[:TrimBodyLimit] + "..."
+// get sends a GET request and decodes the response into result.
+// If the key is not empty, the request is conditional and the validators
+// of the response are stored under the key.
+func (c *Client) get(ctx context.Context, url, key string, validators Validators, result any) (http.Header, error) {
+	req := c.Client.R().
+		SetContext(ctx).
+		SetResult(result)
+
+	if key != "" {
+		validators.apply(req, key)
+	}
+
+	resp, err := req.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.updateRateLimit(resp.StatusCode(), resp.Header()); err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusOK:
+		if key != "" {
+			validators.store(key, resp.Header())
+		}
+
+		return resp.Header(), nil
+	case http.StatusNotModified:
+		return nil, ErrNotModified
+	case http.StatusNotFound, http.StatusGone:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("unexpected status %d", resp.StatusCode())
+	}
+}
+
+// wrapError keeps ErrNotModified and RateLimitError as is, so callers can check them.
+func wrapError(target, err error) error {
+	var rateLimitErr *RateLimitError
+	if errors.Is(err, ErrNotModified) || errors.As(err, &rateLimitErr) {
+		return err
 	}
 
-	return body
+	return fmt.Errorf("%w: %w", target, err)
 }
 
-func getOwnerAndRepo(url string) (owner, repo string, err error) {
-	re := regexp.MustCompile(`(?i)(?:github\.com[/:])?([^/]+)/([^/]+?)(?:\.git)?$`)
-	matches := re.FindStringSubmatch(url)
+// renderBody renders the markdown body as Telegram HTML cut to TrimBodyLimit runes of text.
+func renderBody(body string) string {
+	return tghtml.Truncate(tghtml.FromMarkdown(body), TrimBodyLimit)
+}
 
-	if len(matches) < 3 {
-		return "", "", errors.New("invalid GitHub repository URL format")
+func getOwnerAndRepo(url string) (owner, repo string, err error) {
+	target, err := parseTarget(url)
+	if err != nil {
+		return "", "", err
 	}
 
-	owner = strings.TrimSpace(matches[1])
-	repo = strings.TrimSpace(strings.TrimSuffix(matches[2], ".git"))
+	return target.owner, target.repo, nil
+}
+
+// nextPageURL returns the rel="next" target of the Link header or an empty string on the last page.
+func nextPageURL(header http.Header) string {
+	for _, link := range strings.Split(header.Get("Link"), ",") {
+		target, params, found := strings.Cut(link, ";")
+		if !found {
+			continue
+		}
 
-	if owner == "" || repo == "" {
-		return "", "", errors.New("empty owner or repository name")
+		for _, param := range strings.Split(params, ";") {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(target), "<>")
+			}
+		}
 	}
 
-	return owner, repo, nil
+	return ""
 }
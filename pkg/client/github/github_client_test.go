@@ -3,8 +3,10 @@ package github_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"testing"
 	"time"
 
@@ -36,11 +38,11 @@ func Test_GetRepo_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := github.NewClient()
+	client := github.NewClient("")
 	client.BaseURL = server.URL
 	client.Client = client.Client.SetBaseURL(server.URL)
 
-	repo, err := client.GetRepo(context.Background(), "https://github.com/test/test")
+	repo, err := client.GetRepo(context.Background(), "https://github.com/test/test", nil)
 
 	require.NoError(t, err)
 	assert.Equal(t, int64(123), repo.ID)
@@ -48,8 +50,8 @@ func Test_GetRepo_Success(t *testing.T) {
 }
 
 func Test_GetRepo_InvalidLink(t *testing.T) {
-	client := github.NewClient()
-	_, err := client.GetRepo(context.Background(), "https://bad_link")
+	client := github.NewClient("")
+	_, err := client.GetRepo(context.Background(), "https://bad_link", nil)
 
 	assert.Error(t, err)
 }
@@ -73,25 +75,20 @@ func Test_GetActivity_Success(t *testing.T) {
 			err := json.NewEncoder(w).Encode(response)
 			require.NoError(t, err)
 		case "/repos/test/test/issues":
-			page := r.URL.Query().Get("page")
-			if page == "" || page == "1" {
-				response := []map[string]interface{}{
-					{
-						"title":        "Test issue",
-						"updated_at":   expectedTime.Format(time.RFC3339),
-						"body":         "Test issue body",
-						"pull_request": nil,
-					},
-				}
-
-				w.Header().Set("Content-Type", "application/json")
-				err := json.NewEncoder(w).Encode(response)
-				require.NoError(t, err)
-			} else {
-				w.Header().Set("Content-Type", "application/json")
-				err := json.NewEncoder(w).Encode([]interface{}{})
-				require.NoError(t, err)
+			assert.Equal(t, lastCheckTime.UTC().Format(time.RFC3339), r.URL.Query().Get("since"))
+
+			response := []map[string]interface{}{
+				{
+					"title":        "Test issue",
+					"updated_at":   expectedTime.Format(time.RFC3339),
+					"body":         "Test issue body",
+					"pull_request": nil,
+				},
 			}
+
+			w.Header().Set("Content-Type", "application/json")
+			err := json.NewEncoder(w).Encode(response)
+			require.NoError(t, err)
 		default:
 			w.WriteHeader(http.StatusNotFound)
 		}
@@ -99,7 +96,7 @@ func Test_GetActivity_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := github.NewClient()
+	client := github.NewClient("")
 	client.BaseURL = server.URL
 	client.Client = client.Client.SetBaseURL(server.URL)
 
@@ -110,28 +107,35 @@ func Test_GetActivity_Success(t *testing.T) {
 		Owner:       "testuser",
 	}
 
-	activities, err := client.GetActivity(context.Background(), repo, lastCheckTime)
+	activities, err := client.GetActivity(context.Background(), repo, lastCheckTime, nil)
 	require.NoError(t, err)
 	assert.Len(t, activities, 2)
 }
 
-func Test_GetIssuesByPage_Success(t *testing.T) {
-	expectedTime := time.Now()
+func Test_GetIssuesSince_Success(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := since.Add(time.Hour)
 
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(t, "/repos/test/test/issues", r.URL.Path)
-		assert.Equal(t, "1", r.URL.Query().Get("page"))
+
+		query := r.URL.Query()
+		assert.Equal(t, "2024-01-01T12:00:00Z", query.Get("since"))
+		assert.Equal(t, "all", query.Get("state"))
+		assert.Equal(t, "updated", query.Get("sort"))
+		assert.Equal(t, "desc", query.Get("direction"))
+		assert.Equal(t, "100", query.Get("per_page"))
 
 		response := []map[string]interface{}{
 			{
 				"title":        "Test issue",
-				"updated_at":   expectedTime.Format(time.RFC3339),
+				"updated_at":   updatedAt.Format(time.RFC3339),
 				"body":         "Test issue body",
 				"pull_request": nil,
 			},
 			{
 				"title":        "Test PR",
-				"updated_at":   expectedTime.Format(time.RFC3339),
+				"updated_at":   updatedAt.Format(time.RFC3339),
 				"body":         "Test PR body",
 				"pull_request": map[string]interface{}{},
 			},
@@ -145,11 +149,11 @@ func Test_GetIssuesByPage_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := github.NewClient()
+	client := github.NewClient("")
 	client.BaseURL = server.URL
 	client.Client = client.Client.SetBaseURL(server.URL)
 
-	issues, err := client.GetIssuesByPage(context.Background(), "https://github.com/test/test", 1)
+	issues, err := client.GetIssuesSince(context.Background(), "https://github.com/test/test", since, nil)
 	require.NoError(t, err)
 	require.Len(t, issues, 2)
 	assert.Equal(t, "Test issue", issues[0].Title)
@@ -157,3 +161,277 @@ func Test_GetIssuesByPage_Success(t *testing.T) {
 	assert.Equal(t, "Test PR", issues[1].Title)
 	assert.Equal(t, github.IssueTypePullRequest, issues[1].Type)
 }
+
+func Test_GetIssuesSince_RevisionIgnoresLabels(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := since.Add(time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		response := []map[string]interface{}{
+			{"id": 1, "title": "Bug", "body": "Crash", "state": "open", "updated_at": updatedAt},
+			{
+				"id": 1, "title": "Bug", "body": "Crash", "state": "open", "updated_at": updatedAt,
+				"labels": []map[string]string{{"name": "bug"}},
+			},
+			{"id": 1, "title": "Bug", "body": "Crash", "state": "closed", "updated_at": updatedAt},
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	issues, err := client.GetIssuesSince(context.Background(), "https://github.com/test/test", since, nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 3)
+
+	assert.Equal(t, issues[0].Revision, issues[1].Revision)
+	assert.NotEqual(t, issues[0].Revision, issues[2].Revision)
+}
+
+func Test_GetIssuesSince_FollowsLinkHeader(t *testing.T) {
+	since := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	var server *httptest.Server
+
+	requests := 0
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+
+		var response []map[string]interface{}
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(
+				`<%s/repositories/1/issues?page=2>; rel="next", <%s/repositories/1/issues?page=3>; rel="last"`,
+				server.URL, server.URL,
+			))
+
+			response = []map[string]interface{}{
+				{"title": "First", "updated_at": since.Add(2 * time.Hour).Format(time.RFC3339)},
+			}
+		case "2":
+			assert.Equal(t, "/repositories/1/issues", r.URL.Path)
+
+			w.Header().Set("Link", fmt.Sprintf(`<%s/repositories/1/issues?page=3>; rel="next"`, server.URL))
+
+			response = []map[string]interface{}{
+				{"title": "Second", "updated_at": since.Add(time.Hour).Format(time.RFC3339)},
+				{"title": "Outdated", "updated_at": since.Format(time.RFC3339)},
+			}
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+
+		err := json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	issues, err := client.GetIssuesSince(context.Background(), "https://github.com/test/test", since, nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 2)
+	assert.Equal(t, "First", issues[0].Title)
+	assert.Equal(t, "Second", issues[1].Title)
+	assert.Equal(t, 2, requests)
+}
+
+func Test_GetRepo_AuthToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{"id": 123})
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("test-token")
+	client.BaseURL = server.URL
+
+	_, err := client.GetRepo(context.Background(), "https://github.com/test/test", nil)
+	require.NoError(t, err)
+}
+
+func Test_GetRepo_RateLimitHeaders(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{"id": 123})
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	assert.False(t, client.RateLimit().Exhausted(time.Now()))
+
+	_, err := client.GetRepo(context.Background(), "https://github.com/test/test", nil)
+	require.NoError(t, err)
+
+	rateLimit := client.RateLimit()
+	assert.Equal(t, 5000, rateLimit.Limit)
+	assert.Equal(t, 4999, rateLimit.Remaining)
+	assert.True(t, reset.Equal(rateLimit.Reset))
+	assert.False(t, rateLimit.Exhausted(time.Now()))
+}
+
+func Test_GetRepo_RateLimitExceeded(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "60")
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	_, err := client.GetRepo(context.Background(), "https://github.com/test/test", nil)
+
+	var rateLimitErr *github.RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.True(t, reset.Equal(rateLimitErr.Reset))
+	assert.True(t, client.RateLimit().Exhausted(time.Now()))
+}
+
+func Test_GetIssuesSince_RetryAfter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	_, err := client.GetIssuesSince(context.Background(), "https://github.com/test/test", time.Now(), nil)
+
+	var rateLimitErr *github.RateLimitError
+	require.ErrorAs(t, err, &rateLimitErr)
+	assert.True(t, rateLimitErr.Reset.After(time.Now().Add(59*time.Second)))
+	assert.True(t, client.RateLimit().Exhausted(time.Now()))
+}
+
+func Test_GetRepo_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	_, err := client.GetRepo(context.Background(), "https://github.com/test/test", nil)
+	assert.ErrorIs(t, err, github.ErrFailedToGetRepository)
+	assert.ErrorIs(t, err, github.ErrNotFound)
+}
+
+func Test_GetRepo_StoresValidators(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("If-None-Match"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"abc"`)
+		w.Header().Set("Last-Modified", "Wed, 26 Jan 2011 19:14:43 GMT")
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{"id": 123})
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	validators := github.Validators{}
+
+	_, err := client.GetRepo(context.Background(), "https://github.com/test/test", validators)
+	require.NoError(t, err)
+
+	assert.Equal(t, github.Validators{
+		"repo": {ETag: `"abc"`, LastModified: "Wed, 26 Jan 2011 19:14:43 GMT"},
+	}, validators)
+}
+
+func Test_GetRepo_NotModified(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `"abc"`, r.Header.Get("If-None-Match"))
+		assert.Equal(t, "Wed, 26 Jan 2011 19:14:43 GMT", r.Header.Get("If-Modified-Since"))
+
+		w.WriteHeader(http.StatusNotModified)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	validators := github.Validators{
+		"repo": {ETag: `"abc"`, LastModified: "Wed, 26 Jan 2011 19:14:43 GMT"},
+	}
+
+	_, err := client.GetRepo(context.Background(), "https://github.com/test/test", validators)
+	assert.ErrorIs(t, err, github.ErrNotModified)
+	assert.Len(t, validators, 1)
+}
+
+func Test_GetActivity_IssuesNotModified(t *testing.T) {
+	lastCheckTime := time.Now().Add(-time.Hour)
+
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(t, "/repos/test/test/issues", r.URL.Path)
+		assert.Equal(t, `"issues"`, r.Header.Get("If-None-Match"))
+
+		w.WriteHeader(http.StatusNotModified)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	repo := &github.Repository{
+		URL:       "https://github.com/test/test",
+		UpdatedAt: lastCheckTime,
+	}
+
+	validators := github.Validators{"issues": {ETag: `"issues"`}}
+
+	activities, err := client.GetActivity(context.Background(), repo, lastCheckTime, validators)
+	require.NoError(t, err)
+	assert.Empty(t, activities)
+	assert.Equal(t, 1, requests)
+}
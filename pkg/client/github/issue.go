@@ -12,18 +12,30 @@ const (
 type Issue struct {
 	Type      IssueType
 	ID        int64
+	Revision  string
 	Title     string
 	Body      string
+	UserName  string
+	Labels    []string
+	URL       string
 	UpdatedAt time.Time
 	CreatedAt time.Time
 }
 
-func NewIssue(issueType IssueType, id int64, title, body string, updatedAt, createdAt time.Time) *Issue {
+func NewIssue(
+	issueType IssueType,
+	id int64,
+	title, body, userName string,
+	labels []string,
+	updatedAt, createdAt time.Time,
+) *Issue {
 	return &Issue{
 		Type:      issueType,
 		ID:        id,
 		Title:     title,
 		Body:      body,
+		UserName:  userName,
+		Labels:    labels,
 		UpdatedAt: updatedAt,
 		CreatedAt: createdAt,
 	}
@@ -0,0 +1,88 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// LinkKind is the kind of activity a GitHub link is subscribed to.
+type LinkKind string
+
+const (
+	// LinkKindRepository is github.com/owner/repo.
+	LinkKindRepository LinkKind = "repository"
+	// LinkKindThread is github.com/owner/repo/issues/<number> or github.com/owner/repo/pull/<number>.
+	LinkKindThread LinkKind = "thread"
+	// LinkKindReleases is github.com/owner/repo/releases.
+	LinkKindReleases LinkKind = "releases"
+	// LinkKindTags is github.com/owner/repo/tags.
+	LinkKindTags LinkKind = "tags"
+	// LinkKindBranch is github.com/owner/repo/tree/<branch>.
+	LinkKindBranch LinkKind = "branch"
+)
+
+// gitHubURLRegexp matches a whole repository URL optionally followed by the path of a tracked resource.
+var gitHubURLRegexp = regexp.MustCompile(
+	`(?i)^(?:https?://)?(?:www\.|git@)?github\.com[/:]([^/]+)/([^/]+?)(?:\.git)?(?:/(issues|pull)/(\d+)|/(releases|tags)|/tree/(.+?))?/?$`,
+)
+
+type target struct {
+	kind   LinkKind
+	owner  string
+	repo   string
+	number int
+	isPull bool
+	branch string
+}
+
+// ParseLinkKind returns the kind of the GitHub link.
+func ParseLinkKind(url string) (LinkKind, error) {
+	target, err := parseTarget(url)
+	if err != nil {
+		return "", err
+	}
+
+	return target.kind, nil
+}
+
+func parseTarget(url string) (*target, error) {
+	matches := gitHubURLRegexp.FindStringSubmatch(url)
+
+	if len(matches) < 7 {
+		return nil, errors.New("invalid GitHub repository URL format")
+	}
+
+	t := &target{
+		kind:   LinkKindRepository,
+		owner:  strings.TrimSpace(matches[1]),
+		repo:   strings.TrimSpace(strings.TrimSuffix(matches[2], ".git")),
+		branch: matches[6],
+	}
+
+	if t.owner == "" || t.repo == "" {
+		return nil, errors.New("empty owner or repository name")
+	}
+
+	switch {
+	case matches[4] != "":
+		number, err := strconv.Atoi(matches[4])
+		if err != nil {
+			return nil, fmt.Errorf("invalid issue number: %w", err)
+		}
+
+		t.kind = LinkKindThread
+		t.number = number
+		t.isPull = strings.EqualFold(matches[3], "pull")
+	case strings.EqualFold(matches[5], "releases"):
+		t.kind = LinkKindReleases
+	case strings.EqualFold(matches[5], "tags"):
+		t.kind = LinkKindTags
+	case t.branch != "":
+		t.kind = LinkKindBranch
+	}
+
+	return t, nil
+}
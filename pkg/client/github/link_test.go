@@ -0,0 +1,51 @@
+package github_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/pkg/client/github"
+)
+
+func Test_ParseLinkKind(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected github.LinkKind
+	}{
+		{url: "https://github.com/owner/repo", expected: github.LinkKindRepository},
+		{url: "https://github.com/owner/repo.git", expected: github.LinkKindRepository},
+		{url: "https://github.com/owner/repo/pull/123", expected: github.LinkKindThread},
+		{url: "https://github.com/owner/repo/issues/45/", expected: github.LinkKindThread},
+		{url: "https://github.com/owner/repo/releases", expected: github.LinkKindReleases},
+		{url: "https://github.com/owner/repo/tags", expected: github.LinkKindTags},
+		{url: "https://github.com/owner/repo/tree/main", expected: github.LinkKindBranch},
+		{url: "https://github.com/owner/repo/tree/feature/login", expected: github.LinkKindBranch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			kind, err := github.ParseLinkKind(tt.url)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, kind)
+		})
+	}
+}
+
+func Test_ParseLinkKind_Invalid(t *testing.T) {
+	tests := []string{
+		"https://bad_link",
+		"https://github.com/owner",
+		"https://github.com/owner/repo/blob/main/main.go",
+		"https://github.com.evil.com/owner/repo",
+		"https://example.com/github.com/owner/repo",
+	}
+
+	for _, url := range tests {
+		t.Run(url, func(t *testing.T) {
+			_, err := github.ParseLinkKind(url)
+			assert.Error(t, err)
+		})
+	}
+}
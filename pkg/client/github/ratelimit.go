@@ -0,0 +1,71 @@
+package github
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerRateLimitLimit     = "X-RateLimit-Limit"
+	headerRateLimitRemaining = "X-RateLimit-Remaining"
+	headerRateLimitReset     = "X-RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// RateLimit is the request budget reported by the last GitHub response.
+type RateLimit struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// Exhausted reports whether no request can be sent until Reset.
+// The zero value means the budget is unknown and is never exhausted.
+func (r RateLimit) Exhausted(now time.Time) bool {
+	return !r.Reset.IsZero() && r.Remaining == 0 && now.Before(r.Reset)
+}
+
+func (c *Client) RateLimit() RateLimit {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.rateLimit
+}
+
+// updateRateLimit stores the budget from the response headers and returns
+// a RateLimitError if the response was rejected because of the rate limit.
+func (c *Client) updateRateLimit(statusCode int, header http.Header) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if limit, err := strconv.Atoi(header.Get(headerRateLimitLimit)); err == nil {
+		c.rateLimit.Limit = limit
+	}
+
+	if remaining, err := strconv.Atoi(header.Get(headerRateLimitRemaining)); err == nil {
+		c.rateLimit.Remaining = remaining
+	}
+
+	if reset, err := strconv.ParseInt(header.Get(headerRateLimitReset), 10, 64); err == nil {
+		c.rateLimit.Reset = time.Unix(reset, 0)
+	}
+
+	if statusCode != http.StatusForbidden && statusCode != http.StatusTooManyRequests {
+		return nil
+	}
+
+	// Secondary rate limits are reported with Retry-After in seconds.
+	if retryAfter, err := strconv.Atoi(header.Get(headerRetryAfter)); err == nil {
+		c.rateLimit.Remaining = 0
+		c.rateLimit.Reset = c.timeGetter().Add(time.Duration(retryAfter) * time.Second)
+
+		return &RateLimitError{Reset: c.rateLimit.Reset}
+	}
+
+	if header.Get(headerRateLimitRemaining) == "0" {
+		return &RateLimitError{Reset: c.rateLimit.Reset}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AFK068/bot/pkg/tghtml"
+)
+
+const (
+	validatorKeyIssue  = "issue"
+	validatorKeyChecks = "checks"
+
+	checkRunStatusCompleted = "completed"
+)
+
+// GetThreadActivity returns the activity of a single issue or pull request since the last check time:
+// comments, label and state changes, and for pull requests also reviews, review comments and check runs.
+// Endpoints that respond with Not Modified have no activity.
+func (c *Client) GetThreadActivity(
+	ctx context.Context,
+	threadURL string,
+	lastCheckTime time.Time,
+	validators Validators,
+) ([]*Activity, error) {
+	target, err := parseTarget(threadURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.kind != LinkKindThread {
+		return nil, fmt.Errorf("%s is not an issue or pull request URL", threadURL)
+	}
+
+	owner, repo, number, isPull := target.owner, target.repo, target.number, target.isPull
+
+	threadAPIURL := fmt.Sprintf("%s/repos/%s/%s/issues/%d", c.BaseURL, owner, repo, number)
+
+	var activities []*Activity
+
+	// The issue is updated on every comment, label or state change,
+	// so the timeline is requested only if it changed.
+	var issue issueDTO
+
+	_, err = c.get(ctx, threadAPIURL, validatorKeyIssue, validators, &issue)
+
+	switch {
+	case errors.Is(err, ErrNotModified):
+	case err != nil:
+		return nil, wrapError(ErrFailedToGetIssues, err)
+	case issue.UpdatedAt.After(lastCheckTime):
+		isPull = isPull || issue.PullRequest != nil
+
+		labels := issue.labelNames()
+
+		timeline, err := c.getTimelineActivity(ctx, threadAPIURL, issue.Title, labels, lastCheckTime)
+		if err != nil {
+			return nil, err
+		}
+
+		activities = append(activities, timeline...)
+
+		if isPull {
+			reviewComments, err := c.getReviewCommentActivity(ctx, owner, repo, number, issue.Title, labels, lastCheckTime)
+			if err != nil {
+				return nil, err
+			}
+
+			activities = append(activities, reviewComments...)
+		}
+	}
+
+	if isPull {
+		checks, err := c.getCheckRunActivity(ctx, owner, repo, number, lastCheckTime, validators)
+
+		switch {
+		case errors.Is(err, ErrNotModified):
+		case err != nil:
+			return nil, err
+		default:
+			activities = append(activities, checks...)
+		}
+	}
+
+	return activities, nil
+}
+
+func (c *Client) getTimelineActivity(
+	ctx context.Context,
+	threadAPIURL, title string,
+	labels []string,
+	lastCheckTime time.Time,
+) ([]*Activity, error) {
+	// The timeline is sorted from the oldest event and has no since parameter.
+	pageURL := fmt.Sprintf("%s/timeline?per_page=%d", threadAPIURL, IssuesPerPage)
+
+	var activities []*Activity
+
+	for pageURL != "" {
+		var events []*timelineEventDTO
+
+		header, err := c.get(ctx, pageURL, "", nil, &events)
+		if err != nil {
+			return nil, wrapError(ErrFailedToGetTimeline, err)
+		}
+
+		for _, event := range events {
+			if activity := event.toActivity(title, labels); activity != nil && activity.CreatedAt.After(lastCheckTime) {
+				activities = append(activities, activity)
+			}
+		}
+
+		pageURL = nextPageURL(header)
+	}
+
+	return activities, nil
+}
+
+func (e *timelineEventDTO) toActivity(title string, labels []string) *Activity {
+	switch e.Event {
+	case "commented":
+		activity := NewActivity(ActivityTypeComment, sourceID("comment", e.ID), "", title, e.CreatedAt, renderBody(e.Body), e.author(), labels)
+
+		return activity.withURL(e.HTMLURL)
+	case "reviewed":
+		body := e.State
+		if e.Body != "" {
+			body = fmt.Sprintf("%s: %s", e.State, renderBody(e.Body))
+		}
+
+		activity := NewActivity(ActivityTypeReview, sourceID("review", e.ID), "", title, e.SubmittedAt, body, e.author(), labels)
+
+		return activity.withURL(e.HTMLURL)
+	case "labeled", "unlabeled":
+		if e.Label == nil {
+			return nil
+		}
+
+		body := fmt.Sprintf("%s %s", e.Event, tghtml.Escape(e.Label.Name))
+
+		return NewActivity(ActivityTypeLabel, sourceID("event", e.ID), "", title, e.CreatedAt, body, e.author(), labels)
+	case "closed", "reopened", "merged":
+		return NewActivity(ActivityTypeState, sourceID("event", e.ID), "", title, e.CreatedAt, e.Event, e.author(), labels)
+	}
+
+	return nil
+}
+
+func (c *Client) getReviewCommentActivity(
+	ctx context.Context,
+	owner, repo string,
+	number int,
+	title string,
+	labels []string,
+	lastCheckTime time.Time,
+) ([]*Activity, error) {
+	pageURL := fmt.Sprintf(
+		"%s/repos/%s/%s/pulls/%d/comments?since=%s&per_page=%d",
+		c.BaseURL, owner, repo, number, lastCheckTime.UTC().Format(time.RFC3339), IssuesPerPage,
+	)
+
+	var activities []*Activity
+
+	for pageURL != "" {
+		var comments []*reviewCommentDTO
+
+		header, err := c.get(ctx, pageURL, "", nil, &comments)
+		if err != nil {
+			return nil, wrapError(ErrFailedToGetReviewComments, err)
+		}
+
+		// The since parameter filters by the update time, edited comments are skipped.
+		for _, comment := range comments {
+			if comment.CreatedAt.After(lastCheckTime) {
+				body := fmt.Sprintf("<code>%s</code>: %s", tghtml.Escape(comment.Path), renderBody(comment.Body))
+				activities = append(activities, NewActivity(
+					ActivityTypeReviewComment,
+					sourceID("review_comment", comment.ID),
+					"",
+					title,
+					comment.CreatedAt,
+					body,
+					comment.User.Login,
+					labels,
+				).withURL(comment.HTMLURL))
+			}
+		}
+
+		pageURL = nextPageURL(header)
+	}
+
+	return activities, nil
+}
+
+func (c *Client) getCheckRunActivity(
+	ctx context.Context,
+	owner, repo string,
+	number int,
+	lastCheckTime time.Time,
+	validators Validators,
+) ([]*Activity, error) {
+	// The head ref of the pull request saves a request for the head commit SHA.
+	url := fmt.Sprintf("%s/repos/%s/%s/commits/pull/%d/head/check-runs?per_page=%d", c.BaseURL, owner, repo, number, IssuesPerPage)
+
+	var checkRuns checkRunsDTO
+
+	if _, err := c.get(ctx, url, validatorKeyChecks, validators, &checkRuns); err != nil {
+		return nil, wrapError(ErrFailedToGetCheckRuns, err)
+	}
+
+	var activities []*Activity
+
+	for _, run := range checkRuns.CheckRuns {
+		if run.Status != checkRunStatusCompleted || run.CompletedAt == nil || !run.CompletedAt.After(lastCheckTime) {
+			continue
+		}
+
+		var appName string
+		if run.App != nil {
+			appName = run.App.Name
+		}
+
+		// The conclusion is the revision, so a check run is reported again only if its result changes.
+		activities = append(activities, NewActivity(
+			ActivityTypeCheck,
+			sourceID("check_run", run.ID),
+			run.Conclusion,
+			run.Name,
+			*run.CompletedAt,
+			fmt.Sprintf("%s: %s", tghtml.Escape(run.Name), run.Conclusion),
+			appName,
+			nil,
+		).withURL(run.HTMLURL))
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,159 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/pkg/client/github"
+)
+
+func Test_GetRepo_PullRequestURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/repos/owner/repo", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{"id": 123})
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	repo, err := client.GetRepo(context.Background(), "https://github.com/owner/repo/pull/123", nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(123), repo.ID)
+}
+
+func Test_GetThreadActivity_PullRequest(t *testing.T) {
+	lastCheckTime := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	before := lastCheckTime.Add(-time.Hour).Format(time.RFC3339)
+	after := lastCheckTime.Add(time.Hour).Format(time.RFC3339)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var response interface{}
+
+		switch r.URL.Path {
+		case "/repos/owner/repo/issues/7":
+			response = map[string]interface{}{
+				"title":        "Fix bug",
+				"updated_at":   after,
+				"labels":       []map[string]interface{}{{"name": "bug"}},
+				"pull_request": map[string]interface{}{},
+			}
+		case "/repos/owner/repo/issues/7/timeline":
+			response = []map[string]interface{}{
+				{"event": "commented", "user": map[string]string{"login": "old"}, "body": "Old", "created_at": before},
+				{
+					"id": 11, "event": "commented", "user": map[string]string{"login": "alice"}, "created_at": after,
+					"body":     "**LGTM**, <b>ship</b> `it`\n```go\nrun()",
+					"html_url": "https://github.com/owner/repo/pull/7#issuecomment-11",
+				},
+				{"event": "reviewed", "user": map[string]string{"login": "bob"}, "state": "approved", "submitted_at": after},
+				{"event": "labeled", "actor": map[string]string{"login": "carol"}, "label": map[string]string{"name": "bug"}, "created_at": after},
+				{"event": "merged", "actor": map[string]string{"login": "carol"}, "created_at": after},
+				{"event": "subscribed", "actor": map[string]string{"login": "carol"}, "created_at": after},
+			}
+		case "/repos/owner/repo/pulls/7/comments":
+			assert.Equal(t, "2024-01-01T12:00:00Z", r.URL.Query().Get("since"))
+
+			response = []map[string]interface{}{
+				{"user": map[string]string{"login": "bob"}, "path": "main.go", "body": "Nit", "created_at": after},
+			}
+		case "/repos/owner/repo/commits/pull/7/head/check-runs":
+			response = map[string]interface{}{
+				"check_runs": []map[string]interface{}{
+					{"name": "build", "status": "completed", "conclusion": "success", "completed_at": after},
+					{"name": "lint", "status": "in_progress", "completed_at": nil},
+				},
+			}
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		err := json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	activities, err := client.GetThreadActivity(context.Background(), "https://github.com/owner/repo/pull/7", lastCheckTime, nil)
+	require.NoError(t, err)
+	require.Len(t, activities, 6)
+
+	expected := []struct {
+		activityType github.ActivityType
+		body         string
+		userName     string
+	}{
+		{
+			activityType: github.ActivityTypeComment,
+			body:         "<b>LGTM</b>, &lt;b&gt;ship&lt;/b&gt; <code>it</code>\n<pre><code class=\"language-go\">run()</code></pre>",
+			userName:     "alice",
+		},
+		{activityType: github.ActivityTypeReview, body: "approved", userName: "bob"},
+		{activityType: github.ActivityTypeLabel, body: "labeled bug", userName: "carol"},
+		{activityType: github.ActivityTypeState, body: "merged", userName: "carol"},
+		{activityType: github.ActivityTypeReviewComment, body: "<code>main.go</code>: Nit", userName: "bob"},
+		{activityType: github.ActivityTypeCheck, body: "build: success"},
+	}
+
+	for i, activity := range activities {
+		assert.Equal(t, expected[i].activityType, activity.Type)
+		assert.Equal(t, expected[i].body, activity.Body)
+		assert.Equal(t, expected[i].userName, activity.UserName)
+	}
+
+	assert.Equal(t, "Fix bug", activities[0].Title)
+	assert.Equal(t, []string{"bug"}, activities[0].Labels)
+	assert.Equal(t, "comment:11", activities[0].ID)
+	assert.Equal(t, "https://github.com/owner/repo/pull/7#issuecomment-11", activities[0].URL)
+	assert.Empty(t, activities[2].URL)
+	assert.Equal(t, "success", activities[5].Revision)
+}
+
+func Test_GetThreadActivity_IssueNotModified(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(t, "/repos/owner/repo/issues/7", r.URL.Path)
+		assert.Equal(t, `"issue"`, r.Header.Get("If-None-Match"))
+
+		w.WriteHeader(http.StatusNotModified)
+	}))
+
+	defer server.Close()
+
+	client := github.NewClient("")
+	client.BaseURL = server.URL
+
+	validators := github.Validators{"issue": {ETag: `"issue"`}}
+
+	activities, err := client.GetThreadActivity(context.Background(), "https://github.com/owner/repo/issues/7", time.Now(), validators)
+	require.NoError(t, err)
+	assert.Empty(t, activities)
+	assert.Equal(t, 1, requests)
+}
+
+func Test_GetThreadActivity_RepositoryURL(t *testing.T) {
+	client := github.NewClient("")
+
+	_, err := client.GetThreadActivity(context.Background(), "https://github.com/owner/repo", time.Now(), nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,58 @@
+package github
+
+import (
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	headerETag            = "ETag"
+	headerLastModified    = "Last-Modified"
+	headerIfNoneMatch     = "If-None-Match"
+	headerIfModifiedSince = "If-Modified-Since"
+)
+
+// Validator holds the cache validators of a single endpoint response.
+type Validator struct {
+	ETag         string
+	LastModified string
+}
+
+// Validators maps an endpoint key to the validators of its last response.
+// The client sends them as conditional headers and replaces them with the
+// validators of every successful response, so a nil map disables caching.
+type Validators map[string]Validator
+
+func (v Validators) apply(req *resty.Request, key string) {
+	validator, ok := v[key]
+	if !ok {
+		return
+	}
+
+	if validator.ETag != "" {
+		req.SetHeader(headerIfNoneMatch, validator.ETag)
+	}
+
+	if validator.LastModified != "" {
+		req.SetHeader(headerIfModifiedSince, validator.LastModified)
+	}
+}
+
+func (v Validators) store(key string, header http.Header) {
+	if v == nil {
+		return
+	}
+
+	validator := Validator{
+		ETag:         header.Get(headerETag),
+		LastModified: header.Get(headerLastModified),
+	}
+
+	if validator == (Validator{}) {
+		delete(v, key)
+		return
+	}
+
+	v[key] = validator
+}
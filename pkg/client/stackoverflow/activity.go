@@ -1,5 +1,10 @@
 package stackoverflow
 
+import (
+	"fmt"
+	"strconv"
+)
+
 type ActivityType string
 
 const (
@@ -8,20 +13,56 @@ const (
 	ActivityTypeQuestion ActivityType = "question"
 )
 
+// Activity is a change of a post. ID identifies the post across checks and Revision
+// is its last edit date, so a post bumped by votes or comments is not reported as changed.
+// Comments cannot be edited for long, they have no revision. URL is the web page of the post.
 type Activity struct {
 	Type      ActivityType
+	ID        string
+	Revision  string
 	CreatedAt int64
 	Body      string
 	Tags      []string
 	UserName  string
+	URL       string
 }
 
-func NewActivity(activityType ActivityType, createdAt int64, body string, tags []string, userName string) *Activity {
+func NewActivity(activityType ActivityType, id, revision string, createdAt int64, body string, tags []string, userName string) *Activity {
 	return &Activity{
 		Type:      activityType,
+		ID:        id,
+		Revision:  revision,
 		CreatedAt: createdAt,
 		Body:      body,
 		Tags:      tags,
 		UserName:  userName,
 	}
 }
+
+// withURL sets the web page of the activity to the post of the type on the site.
+func (a *Activity) withURL(site string, id int64) *Activity {
+	a.URL = postURL(site, a.Type, id)
+	return a
+}
+
+// postURL returns the short link of the post of the type, the site redirects it to the post.
+func postURL(site string, activityType ActivityType, id int64) string {
+	switch activityType {
+	case ActivityTypeAnswer:
+		return fmt.Sprintf("https://%s/a/%d", site, id)
+	case ActivityTypeComment:
+		return fmt.Sprintf("https://%s/posts/comments/%d", site, id)
+	default:
+		return fmt.Sprintf("https://%s/q/%d", site, id)
+	}
+}
+
+// sourceID returns the activity ID of the post of the type.
+func sourceID(activityType ActivityType, id int64) string {
+	return fmt.Sprintf("%s:%d", activityType, id)
+}
+
+// editRevision returns the revision of a post from its last edit date.
+func editRevision(lastEditDate int64) string {
+	return strconv.FormatInt(lastEditDate, 10)
+}
@@ -0,0 +1,169 @@
+package stackoverflow
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxBatchSize is the maximum number of IDs the API accepts in one request.
+const MaxBatchSize = 100
+
+// GetQuestions retrieves the questions of one site by their IDs,
+// sending one request per MaxBatchSize IDs.
+func (c *Client) GetQuestions(ctx context.Context, site string, ids []int64) ([]*Question, error) {
+	var questions []*Question
+
+	for _, batch := range chunkIDs(ids) {
+		url := fmt.Sprintf("%s/questions/%s?site=%s&filter=withbody&pagesize=%d", c.BaseURL, batch, site, MaxBatchSize)
+
+		items, err := getItems[questionResponseDTO](ctx, c, methodQuestions, url)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items.Items {
+			question := item.toQuestion()
+			question.Site = site
+
+			questions = append(questions, question)
+		}
+	}
+
+	return questions, nil
+}
+
+// GetQuestionsActivity retrieves the activity of questions of one site since the given time.
+// Answers and comments of all questions are fetched with batched requests and
+// grouped by the question ID. Questions must belong to the given site.
+func (c *Client) GetQuestionsActivity(
+	ctx context.Context,
+	site string,
+	questions []*Question,
+	since time.Time,
+) (map[int64][]*Activity, error) {
+	activities := make(map[int64][]*Activity, len(questions))
+	byID := make(map[int64]*Question, len(questions))
+	ids := make([]int64, 0, len(questions))
+
+	for _, question := range questions {
+		byID[question.ID] = question
+		ids = append(ids, question.ID)
+
+		if question.LastEditDate > since.Unix() {
+			activities[question.ID] = append(activities[question.ID], NewActivity(
+				ActivityTypeQuestion,
+				sourceID(ActivityTypeQuestion, question.ID),
+				editRevision(question.LastEditDate),
+				question.LastEditDate,
+				renderBody(question.Body),
+				question.Tags,
+				question.Name,
+			).withURL(site, question.ID))
+		}
+	}
+
+	for _, batch := range chunkIDs(ids) {
+		answerURL := fmt.Sprintf(
+			"%s/questions/%s/answers?site=%s&filter=withbody&sort=activity&min=%d&pagesize=%d",
+			c.BaseURL, batch, site, since.Unix()+1, MaxBatchSize,
+		)
+
+		err := getPages[answerResponseDTO](ctx, c, methodAnswers, answerURL, func(page *answerResponseDTO) {
+			for _, answer := range page.Items {
+				question, ok := byID[answer.QuestionID]
+				if !ok || answer.LastActivityDate <= since.Unix() {
+					continue
+				}
+
+				activities[question.ID] = append(activities[question.ID], NewActivity(
+					ActivityTypeAnswer,
+					sourceID(ActivityTypeAnswer, answer.ID),
+					editRevision(answer.LastEditDate),
+					answer.LastActivityDate,
+					renderBody(answer.Body),
+					question.Tags,
+					answer.Owner.DisplayName,
+				).withURL(site, answer.ID))
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		commentURL := fmt.Sprintf(
+			"%s/questions/%s/comments?site=%s&filter=withbody&sort=creation&min=%d&pagesize=%d",
+			c.BaseURL, batch, site, since.Unix()+1, MaxBatchSize,
+		)
+
+		err = getPages[commentResponseDTO](ctx, c, methodComments, commentURL, func(page *commentResponseDTO) {
+			for _, comment := range page.Items {
+				question, ok := byID[comment.PostID]
+				if !ok || comment.CreatedAt <= since.Unix() {
+					continue
+				}
+
+				activities[question.ID] = append(activities[question.ID], NewActivity(
+					ActivityTypeComment,
+					sourceID(ActivityTypeComment, comment.ID),
+					"",
+					comment.CreatedAt,
+					renderBody(comment.Body),
+					question.Tags,
+					comment.Owner.DisplayName,
+				).withURL(site, comment.ID))
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return activities, nil
+}
+
+// ParseQuestionID returns the ID of the question the URL points to.
+func ParseQuestionID(url string) (int64, error) {
+	id, err := getIDFromURL(url)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(id, 10, 64)
+}
+
+// getPages requests every page of the URL until the API reports there is no more.
+func getPages[T any, PT response[T]](ctx context.Context, c *Client, method, url string, handle func(page *T)) error {
+	for page := 1; ; page++ {
+		items, err := getItems[T, PT](ctx, c, method, fmt.Sprintf("%s&page=%d", url, page))
+		if err != nil {
+			return err
+		}
+
+		handle(items)
+
+		if !PT(items).wrapper().HasMore {
+			return nil
+		}
+	}
+}
+
+// chunkIDs joins the IDs into semicolon separated batches of at most MaxBatchSize.
+func chunkIDs(ids []int64) []string {
+	var batches []string
+
+	for start := 0; start < len(ids); start += MaxBatchSize {
+		end := min(start+MaxBatchSize, len(ids))
+
+		parts := make([]string, 0, end-start)
+		for _, id := range ids[start:end] {
+			parts = append(parts, strconv.FormatInt(id, 10))
+		}
+
+		batches = append(batches, strings.Join(parts, ";"))
+	}
+
+	return batches
+}
@@ -1,14 +1,33 @@
 package stackoverflow
 
+// wrapperDTO holds the fields of the common response wrapper.
+// Error fields are only set on failed requests.
+type wrapperDTO struct {
+	QuotaMax       int    `json:"quota_max"`
+	QuotaRemaining int    `json:"quota_remaining"`
+	Backoff        int    `json:"backoff"`
+	HasMore        bool   `json:"has_more"`
+	ErrorID        int    `json:"error_id"`
+	ErrorName      string `json:"error_name"`
+	ErrorMessage   string `json:"error_message"`
+}
+
+func (w *wrapperDTO) wrapper() *wrapperDTO {
+	return w
+}
+
 type questionResponseDTO struct {
+	wrapperDTO
 	Items []*questionDTO `json:"items"`
 }
 
 type answerResponseDTO struct {
+	wrapperDTO
 	Items []*answerDTO `json:"items"`
 }
 
 type commentResponseDTO struct {
+	wrapperDTO
 	Items []*commentDTO `json:"items"`
 }
 
@@ -33,6 +52,7 @@ func (q *questionDTO) toQuestion() *Question {
 
 type commentDTO struct {
 	ID        int64    `json:"comment_id"`
+	PostID    int64    `json:"post_id"`
 	Owner     ownerDTO `json:"owner"`
 	CreatedAt int64    `json:"creation_date"`
 	Body      string   `json:"body"`
@@ -40,9 +60,11 @@ type commentDTO struct {
 
 type answerDTO struct {
 	ID               int64    `json:"answer_id"`
+	QuestionID       int64    `json:"question_id"`
 	Owner            ownerDTO `json:"owner"`
 	Body             string   `json:"body"`
 	LastActivityDate int64    `json:"last_activity_date"`
+	LastEditDate     int64    `json:"last_edit_date"`
 }
 
 type ownerDTO struct {
@@ -1,6 +1,10 @@
 package stackoverflow
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var (
 	ErrFailedToGetQuestion = errors.New("failed to get question")
@@ -8,4 +12,15 @@ var (
 	ErrNoAnswersFound      = errors.New("no answers found")
 	ErrFailedToGetItems    = errors.New("failed to get items")
 	ErrInvalidQuestionURL  = errors.New("invalid question url")
+	ErrUnsupportedSite     = errors.New("unsupported stack exchange site")
 )
+
+// QuotaError is returned when the Stack Exchange API quota is exhausted
+// or the caller is throttled. No request should be sent before Reset.
+type QuotaError struct {
+	Reset time.Time
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("stack exchange quota exhausted until %s", e.Reset.Format(time.RFC3339))
+}
@@ -2,6 +2,7 @@ package stackoverflow
 
 type Question struct {
 	ID               int64
+	Site             string
 	Name             string
 	LastActivityDate int64
 	LastEditDate     int64
@@ -0,0 +1,102 @@
+package stackoverflow
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// errorIDThrottleViolation is returned by the API when the caller sent too many requests.
+const errorIDThrottleViolation = 502
+
+// API methods that are backed off independently.
+const (
+	methodQuestions = "questions"
+	methodAnswers   = "questions/answers"
+	methodComments  = "questions/comments"
+)
+
+var throttleSecondsRegexp = regexp.MustCompile(`(\d+) seconds`)
+
+// Quota is the daily request budget reported by the last Stack Exchange response.
+type Quota struct {
+	Max       int
+	Remaining int
+	Reset     time.Time
+}
+
+// Exhausted reports whether no request can be sent until Reset.
+// The zero value means the budget is unknown and is never exhausted.
+func (q Quota) Exhausted(now time.Time) bool {
+	return !q.Reset.IsZero() && q.Remaining == 0 && now.Before(q.Reset)
+}
+
+func (c *Client) Quota() Quota {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.quota
+}
+
+// updateQuota stores the quota and the backoff of the method from the response wrapper
+// and returns a QuotaError if the request was rejected because of throttling.
+func (c *Client) updateQuota(method string, wrapper *wrapperDTO) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.timeGetter()
+
+	if wrapper.QuotaMax > 0 {
+		c.quota.Max = wrapper.QuotaMax
+		c.quota.Remaining = wrapper.QuotaRemaining
+		c.quota.Reset = nextQuotaReset(now)
+	}
+
+	if wrapper.Backoff > 0 {
+		c.backoff[method] = now.Add(time.Duration(wrapper.Backoff) * time.Second)
+	}
+
+	if wrapper.ErrorID != errorIDThrottleViolation {
+		return nil
+	}
+
+	c.quota.Remaining = 0
+	c.quota.Reset = nextQuotaReset(now)
+
+	// Short throttles report the number of seconds until more requests are available.
+	if matches := throttleSecondsRegexp.FindStringSubmatch(wrapper.ErrorMessage); len(matches) == 2 {
+		if seconds, err := strconv.Atoi(matches[1]); err == nil {
+			c.quota.Reset = now.Add(time.Duration(seconds) * time.Second)
+		}
+	}
+
+	return &QuotaError{Reset: c.quota.Reset}
+}
+
+// waitBackoff blocks until the backoff requested for the method has passed.
+func (c *Client) waitBackoff(ctx context.Context, method string) error {
+	c.mu.RLock()
+	until := c.backoff[method]
+	c.mu.RUnlock()
+
+	wait := until.Sub(c.timeGetter())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// nextQuotaReset returns the next UTC midnight, when the daily quota is renewed.
+func nextQuotaReset(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
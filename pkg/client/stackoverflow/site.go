@@ -0,0 +1,55 @@
+package stackoverflow
+
+import (
+	"net/url"
+	"strings"
+)
+
+// DefaultSite is used for questions that were fetched without a known site.
+const DefaultSite = "stackoverflow.com"
+
+// stackExchangeDomain is the parent domain of most Stack Exchange network sites.
+const stackExchangeDomain = ".stackexchange.com"
+
+// stackExchangeHosts are the network sites with their own domains.
+var stackExchangeHosts = map[string]struct{}{
+	"stackoverflow.com":    {},
+	"ru.stackoverflow.com": {},
+	"pt.stackoverflow.com": {},
+	"es.stackoverflow.com": {},
+	"ja.stackoverflow.com": {},
+	"serverfault.com":      {},
+	"superuser.com":        {},
+	"askubuntu.com":        {},
+	"mathoverflow.net":     {},
+	"stackapps.com":        {},
+}
+
+// ParseSite returns the Stack Exchange API site parameter for the URL.
+// The API accepts the full domain name of a site, so the normalized host is used.
+func ParseSite(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Hostname() == "" {
+		return "", ErrUnsupportedSite
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+
+	if strings.HasSuffix(host, stackExchangeDomain) {
+		return host, nil
+	}
+
+	if _, ok := stackExchangeHosts[strings.TrimPrefix(host, "meta.")]; ok {
+		return host, nil
+	}
+
+	return "", ErrUnsupportedSite
+}
+
+func questionSite(question *Question) string {
+	if question.Site == "" {
+		return DefaultSite
+	}
+
+	return question.Site
+}
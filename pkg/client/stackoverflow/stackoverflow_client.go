@@ -2,12 +2,16 @@ package stackoverflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+
+	"github.com/AFK068/bot/pkg/tghtml"
 )
 
 var (
@@ -18,47 +22,59 @@ var (
 type Client struct {
 	BaseURL string
 	Client  *resty.Client
+
+	key        string
+	timeGetter func() time.Time
+	quota      Quota
+	backoff    map[string]time.Time
+	mu         sync.RWMutex
 }
 
-func NewClient() *Client {
+// NewClient creates a Stack Exchange client. The app key is optional,
+// but anonymous callers share a much smaller daily quota.
+func NewClient(key string) *Client {
 	return &Client{
-		BaseURL: BaseStackOverflowAPIURL,
-		Client:  resty.New().SetTimeout(10 * time.Second),
+		BaseURL:    BaseStackOverflowAPIURL,
+		Client:     resty.New().SetTimeout(10 * time.Second),
+		key:        key,
+		timeGetter: time.Now,
+		backoff:    make(map[string]time.Time),
 	}
 }
 
-// GetQuestion retrieves a question from the Stack Overflow API using its URL.
+// GetQuestion retrieves a question from the Stack Exchange API using its URL.
+// The API site is derived from the URL host.
 func (c *Client) GetQuestion(ctx context.Context, questionURL string) (*Question, error) {
-	questionID, err := getIDFromURL(questionURL)
+	site, err := ParseSite(questionURL)
 	if err != nil {
 		return nil, err
 	}
 
-	url := fmt.Sprintf("%s/questions/%s?site=stackoverflow&filter=withbody", c.BaseURL, questionID)
+	questionID, err := getIDFromURL(questionURL)
+	if err != nil {
+		return nil, err
+	}
 
-	var quesionResponseDTO questionResponseDTO
+	url := fmt.Sprintf("%s/questions/%s?site=%s&filter=withbody", c.BaseURL, questionID, site)
 
-	resp, err := c.Client.R().
-		SetContext(ctx).
-		SetResult(&quesionResponseDTO).
-		Get(url)
+	quesion, err := getItems[questionResponseDTO](ctx, c, methodQuestions, url)
 	if err != nil {
-		return nil, ErrFailedToGetQuestion
-	}
+		var quotaErr *QuotaError
+		if errors.As(err, &quotaErr) {
+			return nil, err
+		}
 
-	if resp.StatusCode() != http.StatusOK {
 		return nil, ErrFailedToGetQuestion
 	}
 
-	quesion := resp.Result().(*questionResponseDTO)
 	if len(quesion.Items) == 0 {
 		return nil, ErrQuestionNotFound
 	}
 
-	// Trim the body of the question to a certain limit.
-	quesion.Items[0].Body = trimBody(quesion.Items[0].Body)
+	question := quesion.Items[0].toQuestion()
+	question.Site = site
 
-	return quesion.Items[0].toQuestion(), nil
+	return question, nil
 }
 
 // GetActivity retrieves the activity of a given question since the last check time.
@@ -74,11 +90,13 @@ func (c *Client) GetActivity(ctx context.Context, question *Question, lastCheckT
 	if question.LastEditDate > lastCheckTime.Unix() {
 		activity := NewActivity(
 			ActivityTypeQuestion,
+			sourceID(ActivityTypeQuestion, question.ID),
+			editRevision(question.LastEditDate),
 			question.LastEditDate,
-			trimBody(question.Body),
+			renderBody(question.Body),
 			question.Tags,
 			question.Name,
-		)
+		).withURL(questionSite(question), question.ID)
 
 		activities = append(activities, activity)
 	}
@@ -109,9 +127,9 @@ func (c *Client) GetActivity(ctx context.Context, question *Question, lastCheckT
 func (c *Client) GetQuestionCommentActivity(ctx context.Context, question *Question, lastCheckTime time.Time) ([]*Activity, error) {
 	var activities []*Activity
 
-	commentURL := fmt.Sprintf("%s/questions/%d/comments?site=stackoverflow&filter=withbody", c.BaseURL, question.ID)
+	commentURL := fmt.Sprintf("%s/questions/%d/comments?site=%s&filter=withbody", c.BaseURL, question.ID, questionSite(question))
 
-	commentItems, err := getItems[commentResponseDTO](ctx, c.Client, commentURL)
+	commentItems, err := getItems[commentResponseDTO](ctx, c, methodComments, commentURL)
 	if err != nil {
 		return nil, err
 	}
@@ -121,11 +139,13 @@ func (c *Client) GetQuestionCommentActivity(ctx context.Context, question *Quest
 			if comment.CreatedAt > lastCheckTime.Unix() {
 				activity := NewActivity(
 					ActivityTypeComment,
+					sourceID(ActivityTypeComment, comment.ID),
+					"",
 					comment.CreatedAt,
-					trimBody(comment.Body),
+					renderBody(comment.Body),
 					question.Tags,
 					comment.Owner.DisplayName,
-				)
+				).withURL(questionSite(question), comment.ID)
 
 				activities = append(activities, activity)
 			}
@@ -142,9 +162,9 @@ func (c *Client) GetQuestionCommentActivity(ctx context.Context, question *Quest
 func (c *Client) GetQuestionAnswerActivity(ctx context.Context, question *Question, lastCheckTime time.Time) ([]*Activity, error) {
 	var activities []*Activity
 
-	answerURL := fmt.Sprintf("%s/questions/%d/answers?site=stackoverflow&filter=withbody", c.BaseURL, question.ID)
+	answerURL := fmt.Sprintf("%s/questions/%d/answers?site=%s&filter=withbody", c.BaseURL, question.ID, questionSite(question))
 
-	answerItems, err := getItems[answerResponseDTO](ctx, c.Client, answerURL)
+	answerItems, err := getItems[answerResponseDTO](ctx, c, methodAnswers, answerURL)
 	if err != nil {
 		return nil, err
 	}
@@ -154,11 +174,13 @@ func (c *Client) GetQuestionAnswerActivity(ctx context.Context, question *Questi
 			if answer.LastActivityDate > lastCheckTime.Unix() {
 				activity := NewActivity(
 					ActivityTypeAnswer,
+					sourceID(ActivityTypeAnswer, answer.ID),
+					editRevision(answer.LastEditDate),
 					answer.LastActivityDate,
-					trimBody(answer.Body),
+					renderBody(answer.Body),
 					question.Tags,
 					answer.Owner.DisplayName,
-				)
+				).withURL(questionSite(question), answer.ID)
 
 				activities = append(activities, activity)
 			}
@@ -168,30 +190,52 @@ func (c *Client) GetQuestionAnswerActivity(ctx context.Context, question *Questi
 	return activities, nil
 }
 
-func getItems[T any](ctx context.Context, client *resty.Client, url string) (*T, error) {
-	result := new(T)
+type response[T any] interface {
+	*T
+	wrapper() *wrapperDTO
+}
+
+// getItems waits for the backoff of the method, sends the request and
+// records the quota and backoff returned in the response wrapper.
+func getItems[T any, PT response[T]](ctx context.Context, c *Client, method, url string) (*T, error) {
+	if quota := c.Quota(); quota.Exhausted(c.timeGetter()) {
+		return nil, &QuotaError{Reset: quota.Reset}
+	}
+
+	if err := c.waitBackoff(ctx, method); err != nil {
+		return nil, err
+	}
 
-	resp, err := client.R().
+	result := PT(new(T))
+
+	req := c.Client.R().
 		SetContext(ctx).
 		SetResult(result).
-		Get(url)
+		SetError(result)
+
+	if c.key != "" {
+		req.SetQueryParam("key", c.key)
+	}
+
+	resp, err := req.Get(url)
 	if err != nil {
-		return result, err
+		return nil, err
+	}
+
+	if err := c.updateQuota(method, result.wrapper()); err != nil {
+		return nil, err
 	}
 
 	if resp.StatusCode() != http.StatusOK {
 		return nil, ErrFailedToGetItems
 	}
 
-	return result, nil
+	return (*T)(result), nil
 }
 
-func trimBody(body string) string {
-	if len(body) > TrimBodyLimit {
-		return body[:TrimBodyLimit] + "..."
-	}
-
-	return body
+// renderBody renders the HTML body as Telegram HTML cut to TrimBodyLimit runes of text.
+func renderBody(body string) string {
+	return tghtml.Truncate(tghtml.FromHTML(body), TrimBodyLimit)
 }
 
 func getIDFromURL(url string) (string, error) {
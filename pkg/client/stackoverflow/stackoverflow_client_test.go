@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/AFK068/bot/pkg/client/stackoverflow"
+	"github.com/AFK068/bot/pkg/tghtml"
 )
 
 func Test_GetRepo_Success(t *testing.T) {
@@ -39,7 +42,7 @@ func Test_GetRepo_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := stackoverflow.NewClient()
+	client := stackoverflow.NewClient("")
 	client.BaseURL = server.URL
 	client.Client = client.Client.SetBaseURL(server.URL)
 
@@ -50,8 +53,38 @@ func Test_GetRepo_Success(t *testing.T) {
 	assert.Equal(t, expectedTime.Unix(), question.LastActivityDate)
 }
 
+func Test_GetQuestion_StackExchangeSite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/questions/123", r.URL.Path)
+		assert.Equal(t, "serverfault.com", r.URL.Query().Get("site"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(`{"items":[{"question_id":123}]}`))
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	question, err := client.GetQuestion(context.Background(), "https://serverfault.com/questions/123/title")
+	require.NoError(t, err)
+
+	assert.Equal(t, "serverfault.com", question.Site)
+}
+
+func Test_ParseSite_Unsupported(t *testing.T) {
+	for _, url := range []string{"https://example.com/questions/1", "stackoverflow.com/questions/1", "https://notstackexchange.com/q/1"} {
+		_, err := stackoverflow.ParseSite(url)
+		assert.ErrorIs(t, err, stackoverflow.ErrUnsupportedSite, url)
+	}
+}
+
 func Test_GetRepo_InvalidLink(t *testing.T) {
-	client := stackoverflow.NewClient()
+	client := stackoverflow.NewClient("")
 	_, err := client.GetQuestion(context.Background(), "https://bad_link")
 
 	assert.Error(t, err)
@@ -111,7 +144,7 @@ func Test_GetActivity_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := stackoverflow.NewClient()
+	client := stackoverflow.NewClient("")
 	client.BaseURL = server.URL
 
 	question := &stackoverflow.Question{
@@ -157,7 +190,7 @@ func Test_GetQuestionCommentActivity_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := stackoverflow.NewClient()
+	client := stackoverflow.NewClient("")
 	client.BaseURL = server.URL
 
 	question := &stackoverflow.Question{
@@ -205,7 +238,7 @@ func Test_GetQuestionAnswerActivity_Success(t *testing.T) {
 
 	defer server.Close()
 
-	client := stackoverflow.NewClient()
+	client := stackoverflow.NewClient("")
 	client.BaseURL = server.URL
 
 	question := &stackoverflow.Question{
@@ -225,3 +258,256 @@ func Test_GetQuestionAnswerActivity_Success(t *testing.T) {
 	assert.Equal(t, "AnswerUser", activity.UserName)
 	assert.Equal(t, []string{"go", "api"}, activity.Tags)
 }
+
+func Test_GetQuestionAnswerActivity_RenderedBody(t *testing.T) {
+	body := "<p>Используйте <code>strings.Builder</code>:</p>\n<pre><code>var b strings.Builder\n</code></pre>\n<p>" +
+		strings.Repeat("Ответ ", 40) + "</p>"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"items": []map[string]interface{}{
+				{"answer_id": 1, "body": body, "last_activity_date": 150, "owner": map[string]interface{}{"display_name": "AnswerUser"}},
+			},
+		})
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	activities, err := client.GetQuestionAnswerActivity(context.Background(), &stackoverflow.Question{ID: 123}, time.Unix(100, 0))
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+
+	activity := activities[0]
+	assert.True(t, utf8.ValidString(activity.Body))
+	assert.True(t, strings.HasPrefix(activity.Body, "Используйте <code>strings.Builder</code>:\n\n<pre><code>var b strings.Builder\n</code></pre>"))
+	assert.True(t, strings.HasSuffix(activity.Body, tghtml.Ellipsis))
+	assert.Equal(t, stackoverflow.TrimBodyLimit, tghtml.Length(activity.Body))
+}
+
+func Test_GetQuestion_AppKeyAndQuota(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.URL.Query().Get("key"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(`{"items":[{"question_id":123}],"quota_max":10000,"quota_remaining":9999}`))
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("secret")
+	client.BaseURL = server.URL
+
+	assert.False(t, client.Quota().Exhausted(time.Now()))
+
+	_, err := client.GetQuestion(context.Background(), "https://stackoverflow.com/questions/123")
+	require.NoError(t, err)
+
+	quota := client.Quota()
+	assert.Equal(t, 10000, quota.Max)
+	assert.Equal(t, 9999, quota.Remaining)
+	assert.False(t, quota.Exhausted(time.Now()))
+}
+
+func Test_GetQuestion_QuotaExhausted(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(`{"items":[{"question_id":123}],"quota_max":300,"quota_remaining":0}`))
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	_, err := client.GetQuestion(context.Background(), "https://stackoverflow.com/questions/123")
+	require.NoError(t, err)
+	assert.True(t, client.Quota().Exhausted(time.Now()))
+
+	_, err = client.GetQuestion(context.Background(), "https://stackoverflow.com/questions/123")
+
+	var quotaErr *stackoverflow.QuotaError
+	require.ErrorAs(t, err, &quotaErr)
+	assert.Equal(t, 1, requests)
+}
+
+func Test_GetQuestion_ThrottleViolation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+
+		_, err := w.Write([]byte(`{"error_id":502,"error_name":"throttle_violation",` +
+			`"error_message":"too many requests from this IP, more requests available in 60 seconds"}`))
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	_, err := client.GetQuestion(context.Background(), "https://stackoverflow.com/questions/123")
+
+	var quotaErr *stackoverflow.QuotaError
+	require.ErrorAs(t, err, &quotaErr)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), quotaErr.Reset, 5*time.Second)
+	assert.True(t, client.Quota().Exhausted(time.Now()))
+}
+
+func Test_GetQuestionAnswerActivity_Backoff(t *testing.T) {
+	var requests []time.Time
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests = append(requests, time.Now())
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(`{"items":[],"backoff":1}`))
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	question := &stackoverflow.Question{ID: 123}
+
+	for range 2 {
+		_, err := client.GetQuestionAnswerActivity(context.Background(), question, time.Unix(0, 0))
+		require.NoError(t, err)
+	}
+
+	require.Len(t, requests, 2)
+	assert.GreaterOrEqual(t, requests[1].Sub(requests[0]), time.Second)
+
+	// The backoff applies to the answers method only.
+	start := time.Now()
+
+	_, err := client.GetQuestionCommentActivity(context.Background(), question, time.Unix(0, 0))
+	require.NoError(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func Test_GetQuestions_Batches(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		assert.Equal(t, "superuser.com", r.URL.Query().Get("site"))
+
+		ids := strings.Split(strings.TrimPrefix(r.URL.Path, "/questions/"), ";")
+
+		items := make([]map[string]interface{}, 0, len(ids))
+		for _, id := range ids {
+			items = append(items, map[string]interface{}{"question_id": json.RawMessage(id)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err := json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+		require.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	ids := make([]int64, stackoverflow.MaxBatchSize+1)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	questions, err := client.GetQuestions(context.Background(), "superuser.com", ids)
+	require.NoError(t, err)
+
+	require.Len(t, paths, 2)
+	assert.True(t, strings.HasPrefix(paths[0], "/questions/1;2;3;"))
+	assert.Equal(t, "/questions/101", paths[1])
+
+	require.Len(t, questions, len(ids))
+	assert.Equal(t, "superuser.com", questions[0].Site)
+}
+
+func Test_GetQuestionsActivity_Success(t *testing.T) {
+	since := time.Unix(100, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+
+		switch r.URL.Path {
+		case "/questions/1;2/answers":
+			assert.Equal(t, "101", r.URL.Query().Get("min"))
+
+			if r.URL.Query().Get("page") == "1" {
+				response = `{"items":[{"answer_id":10,"question_id":1,"body":"First","last_activity_date":150,` +
+					`"last_edit_date":140,"owner":{"display_name":"A"}}],"has_more":true}`
+			} else {
+				response = `{"items":[{"question_id":2,"body":"Second","last_activity_date":160}],"has_more":false}`
+			}
+		case "/questions/1;2/comments":
+			response = `{"items":[{"comment_id":30,"post_id":2,"body":"Comment","creation_date":170},` +
+				`{"post_id":2,"body":"Old","creation_date":90}]}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+
+	defer server.Close()
+
+	client := stackoverflow.NewClient("")
+	client.BaseURL = server.URL
+
+	questions := []*stackoverflow.Question{
+		{ID: 1, Tags: []string{"go"}, LastEditDate: 120, Name: "Asker"},
+		{ID: 2},
+	}
+
+	activities, err := client.GetQuestionsActivity(context.Background(), "stackoverflow.com", questions, since)
+	require.NoError(t, err)
+
+	require.Len(t, activities[1], 2)
+	assert.Equal(t, stackoverflow.ActivityTypeQuestion, activities[1][0].Type)
+	assert.Equal(t, stackoverflow.ActivityTypeAnswer, activities[1][1].Type)
+	assert.Equal(t, "A", activities[1][1].UserName)
+	assert.Equal(t, []string{"go"}, activities[1][1].Tags)
+
+	assert.Equal(t, "question:1", activities[1][0].ID)
+	assert.Equal(t, "120", activities[1][0].Revision)
+	assert.Equal(t, "answer:10", activities[1][1].ID)
+	assert.Equal(t, "140", activities[1][1].Revision)
+
+	require.Len(t, activities[2], 2)
+	assert.Equal(t, "Second", activities[2][0].Body)
+	assert.Equal(t, stackoverflow.ActivityTypeComment, activities[2][1].Type)
+	assert.Equal(t, "comment:30", activities[2][1].ID)
+
+	assert.Equal(t, "https://stackoverflow.com/q/1", activities[1][0].URL)
+	assert.Equal(t, "https://stackoverflow.com/a/10", activities[1][1].URL)
+	assert.Equal(t, "https://stackoverflow.com/posts/comments/30", activities[2][1].URL)
+}
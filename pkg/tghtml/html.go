@@ -0,0 +1,317 @@
+// Package tghtml renders the bodies of provider activities as Telegram HTML, the subset of HTML
+// accepted by the Bot API, and cuts and splits such text without breaking runes or tags.
+package tghtml
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Ellipsis marks the end of a truncated text.
+const Ellipsis = "…"
+
+// tagNames maps the HTML formatting tags to the Telegram tags rendering them.
+var tagNames = map[string]string{
+	"b":          "b",
+	"strong":     "b",
+	"i":          "i",
+	"em":         "i",
+	"u":          "u",
+	"ins":        "u",
+	"s":          "s",
+	"strike":     "s",
+	"del":        "s",
+	"a":          "a",
+	"code":       "code",
+	"pre":        "pre",
+	"blockquote": "blockquote",
+	"h1":         "b",
+	"h2":         "b",
+	"h3":         "b",
+	"h4":         "b",
+	"h5":         "b",
+	"h6":         "b",
+}
+
+// voidTags have no content and no end tag.
+var voidTags = map[string]bool{
+	"br": true, "hr": true, "img": true, "wbr": true, "input": true, "meta": true, "link": true,
+}
+
+// hiddenTags have content that is not text of the body.
+var hiddenTags = map[string]bool{"script": true, "style": true, "template": true}
+
+// blockTags are separated from the surrounding text by an empty line.
+var blockTags = map[string]bool{
+	"p": true, "div": true, "pre": true, "blockquote": true, "table": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+}
+
+var (
+	textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+	attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+	spaces     = regexp.MustCompile(`[ \t\r\n\f]+`)
+	emptyLines = regexp.MustCompile(`\n[ \t]*\n(?:[ \t]*\n)+`)
+)
+
+// Escape escapes the plain text for Telegram HTML.
+func Escape(text string) string {
+	return textEscaper.Replace(text)
+}
+
+// FromHTML converts an HTML body, such as a Stack Exchange post, to Telegram HTML. Formatting,
+// links and code blocks are kept, paragraphs and list items become lines, other tags are dropped.
+func FromHTML(body string) string {
+	r := &renderer{layout: true}
+	r.render(body)
+
+	return strings.TrimSpace(emptyLines.ReplaceAllString(r.out.String(), "\n\n"))
+}
+
+// Sanitize makes the text safe to send as Telegram HTML. Supported tags are kept, other tags are
+// dropped and stray special characters are escaped, so plain text is shown as it is.
+func Sanitize(text string) string {
+	r := &renderer{}
+	r.render(text)
+
+	return r.out.String()
+}
+
+// openTag is a tag of the output that has not been closed yet. Name is empty for tags of
+// the input that are not rendered.
+type openTag struct {
+	source string
+	name   string
+}
+
+type renderer struct {
+	out    strings.Builder
+	open   []openTag
+	layout bool
+}
+
+func (r *renderer) render(body string) {
+	z := html.NewTokenizer(strings.NewReader(body))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			r.closeAll()
+			return
+		case html.TextToken:
+			r.text(string(z.Text()))
+		case html.StartTagToken:
+			if token := z.Token(); voidTags[token.Data] {
+				r.void(token.Data)
+			} else {
+				r.start(token)
+			}
+		case html.SelfClosingTagToken:
+			r.void(z.Token().Data)
+		case html.EndTagToken:
+			r.end(z.Token())
+		case html.CommentToken, html.DoctypeToken:
+		}
+	}
+}
+
+func (r *renderer) text(text string) {
+	for _, tag := range r.open {
+		if hiddenTags[tag.source] {
+			return
+		}
+	}
+
+	if r.layout && !r.inside("pre") {
+		text = spaces.ReplaceAllString(text, " ")
+
+		if r.atLineStart() {
+			text = strings.TrimLeft(text, " ")
+		}
+	}
+
+	r.out.WriteString(Escape(text))
+}
+
+func (r *renderer) start(token html.Token) {
+	if r.layout {
+		r.startBlock(token.Data)
+	}
+
+	name := tagNames[token.Data]
+
+	// Code cannot contain other entities but the code of a code block, and links cannot be nested.
+	codeBlock := name == "code" && len(r.open) > 0 && r.open[len(r.open)-1].name == "pre"
+	if (r.inside("pre") && !codeBlock) || r.inside("code") || (name == "a" && r.inside("a")) {
+		name = ""
+	}
+
+	attrs := ""
+
+	switch name {
+	case "a":
+		href := attr(token, "href")
+		if !strings.HasPrefix(href, "http://") && !strings.HasPrefix(href, "https://") {
+			name = ""
+			break
+		}
+
+		attrs = ` href="` + attrEscaper.Replace(href) + `"`
+	case "code":
+		if language := codeLanguage(attr(token, "class")); language != "" {
+			attrs = ` class="language-` + attrEscaper.Replace(language) + `"`
+		}
+	}
+
+	if name != "" {
+		r.out.WriteString("<" + name + attrs + ">")
+	}
+
+	r.open = append(r.open, openTag{source: token.Data, name: name})
+}
+
+// void lays out the tag without content, only line breaks are rendered.
+func (r *renderer) void(tag string) {
+	if !r.layout {
+		return
+	}
+
+	switch tag {
+	case "br":
+		r.trimLine()
+		r.out.WriteString("\n")
+	case "hr":
+		r.lineBreak()
+	}
+}
+
+func (r *renderer) end(token html.Token) {
+	for i := len(r.open) - 1; i >= 0; i-- {
+		if r.open[i].source != token.Data {
+			continue
+		}
+
+		for len(r.open) > i {
+			r.closeLast()
+		}
+
+		if r.layout && blockTags[token.Data] {
+			r.paragraphBreak()
+		}
+
+		return
+	}
+}
+
+// startBlock lays out the block tag as lines of the message.
+func (r *renderer) startBlock(tag string) {
+	switch {
+	case blockTags[tag]:
+		r.paragraphBreak()
+	case tag == "li":
+		r.lineBreak()
+		r.out.WriteString("• ")
+	case tag == "ul" || tag == "ol" || tag == "tr":
+		r.lineBreak()
+	}
+}
+
+func (r *renderer) closeLast() {
+	last := r.open[len(r.open)-1]
+	r.open = r.open[:len(r.open)-1]
+
+	if last.name != "" {
+		r.out.WriteString("</" + last.name + ">")
+	}
+}
+
+func (r *renderer) closeAll() {
+	for len(r.open) > 0 {
+		r.closeLast()
+	}
+}
+
+func (r *renderer) inside(name string) bool {
+	for _, tag := range r.open {
+		if tag.name == name || (tag.name == "" && tagNames[tag.source] == name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *renderer) atLineStart() bool {
+	out := r.out.String()
+	return out == "" || strings.HasSuffix(out, "\n") || strings.HasSuffix(out, "• ")
+}
+
+func (r *renderer) lineBreak() {
+	r.trimLine()
+
+	if !r.atLineStart() {
+		r.out.WriteString("\n")
+	}
+}
+
+func (r *renderer) paragraphBreak() {
+	// Paragraphs of a list item are kept together.
+	for _, tag := range r.open {
+		if tag.source == "li" {
+			r.lineBreak()
+			return
+		}
+	}
+
+	r.trimLine()
+
+	out := r.out.String()
+
+	switch {
+	case out == "" || strings.HasSuffix(out, "• ") || strings.HasSuffix(out, "\n\n"):
+	case strings.HasSuffix(out, "\n"):
+		r.out.WriteString("\n")
+	default:
+		r.out.WriteString("\n\n")
+	}
+}
+
+// trimLine removes the spaces left at the end of the line by collapsed whitespace.
+func (r *renderer) trimLine() {
+	out := r.out.String()
+
+	if r.inside("pre") || strings.HasSuffix(out, "• ") {
+		return
+	}
+
+	if trimmed := strings.TrimRight(out, " "); len(trimmed) < len(out) {
+		r.out.Reset()
+		r.out.WriteString(trimmed)
+	}
+}
+
+func attr(token html.Token, key string) string {
+	for _, attribute := range token.Attr {
+		if attribute.Key == key {
+			return attribute.Val
+		}
+	}
+
+	return ""
+}
+
+// codeLanguage returns the language of a code block from its class, such as lang-go or language-go.
+func codeLanguage(class string) string {
+	for _, name := range strings.Fields(class) {
+		for _, prefix := range []string{"language-", "lang-"} {
+			if language, ok := strings.CutPrefix(name, prefix); ok && language != "" {
+				return language
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,213 @@
+package tghtml
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	fence       = regexp.MustCompile("^\\s*(```+|~~~+)\\s*([\\w+#.-]*)")
+	heading     = regexp.MustCompile(`^\s{0,3}#{1,6}\s+(.*?)\s*#*\s*$`)
+	listItem    = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(.*)$`)
+	quote       = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+	comment     = regexp.MustCompile(`(?s)<!--.*?-->`)
+	image       = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	link        = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)(?:\s+"[^"]*")?\)`)
+	bold        = regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*|__(\S(?:.*?\S)?)__`)
+	strike      = regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`)
+	italic      = regexp.MustCompile(`\*(\S(?:[^*]*?\S)?)\*`)
+	placeholder = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// codeBlock is a fenced code block of a markdown body.
+type codeBlock struct {
+	marker   string
+	language string
+	lines    []string
+}
+
+func (b *codeBlock) String() string {
+	code := Escape(strings.Join(b.lines, "\n"))
+
+	if b.language == "" {
+		return "<pre>" + code + "</pre>"
+	}
+
+	return `<pre><code class="language-` + attrEscaper.Replace(b.language) + `">` + code + "</code></pre>"
+}
+
+// FromMarkdown converts a GitHub markdown body to Telegram HTML. Code blocks, inline code, links,
+// emphasis, headings, lists and quotes are kept, HTML comments are dropped and other HTML is shown as text.
+func FromMarkdown(body string) string {
+	var (
+		out   strings.Builder
+		code  *codeBlock
+		quote []string
+	)
+
+	lines := strings.Split(strings.ReplaceAll(body, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if code != nil {
+			if strings.HasPrefix(strings.TrimSpace(line), code.marker) {
+				out.WriteString(code.String() + "\n")
+
+				code = nil
+			} else {
+				code.lines = append(code.lines, line)
+			}
+
+			continue
+		}
+
+		// Comments are dropped before the line is rendered, so they may span lines.
+		if strings.Contains(line, "<!--") {
+			end := commentEnd(lines, i)
+			line = comment.ReplaceAllString(strings.Join(lines[i:end+1], "\n"), "")
+			i = end
+		}
+
+		if text, ok := quoteText(line); ok {
+			quote = append(quote, renderInline(text))
+			continue
+		}
+
+		if len(quote) > 0 {
+			out.WriteString("<blockquote>" + strings.Join(quote, "\n") + "</blockquote>\n")
+
+			quote = nil
+		}
+
+		if match := fence.FindStringSubmatch(line); match != nil {
+			code = &codeBlock{marker: match[1], language: match[2]}
+			continue
+		}
+
+		out.WriteString(renderLine(line) + "\n")
+	}
+
+	if len(quote) > 0 {
+		out.WriteString("<blockquote>" + strings.Join(quote, "\n") + "</blockquote>")
+	}
+
+	// Unclosed code blocks end with the body, as on GitHub.
+	if code != nil {
+		out.WriteString(code.String())
+	}
+
+	// Emphasis markers may overlap, sanitizing closes the tags in order.
+	return strings.TrimSpace(emptyLines.ReplaceAllString(Sanitize(out.String()), "\n\n"))
+}
+
+// commentEnd returns the index of the line closing the comment opened on the line, or the line itself.
+func commentEnd(lines []string, start int) int {
+	text := lines[start]
+
+	for i := start; i < len(lines); i++ {
+		if i > start {
+			text += "\n" + lines[i]
+		}
+
+		if strings.LastIndex(text, "-->") > strings.LastIndex(text, "<!--") {
+			return i
+		}
+	}
+
+	return start
+}
+
+func quoteText(line string) (string, bool) {
+	match := quote.FindStringSubmatch(line)
+	if match == nil {
+		return "", false
+	}
+
+	return match[1], true
+}
+
+// renderLine renders a line outside code blocks, headings become bold and list items get bullets.
+func renderLine(line string) string {
+	if match := heading.FindStringSubmatch(line); match != nil {
+		return "<b>" + renderInline(match[1]) + "</b>"
+	}
+
+	if match := listItem.FindStringSubmatch(line); match != nil {
+		return match[1] + "• " + renderInline(match[2])
+	}
+
+	return renderInline(line)
+}
+
+// renderInline renders the inline code, links and emphasis of the text.
+func renderInline(text string) string {
+	var out strings.Builder
+
+	for {
+		start := strings.Index(text, "`")
+		if start < 0 {
+			break
+		}
+
+		end := strings.Index(text[start+1:], "`")
+		if end < 0 {
+			break
+		}
+
+		end += start + 1
+
+		out.WriteString(renderLinks(text[:start]))
+		out.WriteString("<code>" + Escape(text[start+1:end]) + "</code>")
+
+		text = text[end+1:]
+	}
+
+	out.WriteString(renderLinks(text))
+
+	return out.String()
+}
+
+// renderLinks renders the links and the emphasis of the text. Links are replaced by placeholders
+// while the emphasis is rendered, so markers in their targets are left alone.
+func renderLinks(text string) string {
+	var links []string
+
+	replace := func(pattern *regexp.Regexp, defaultText string) {
+		text = pattern.ReplaceAllStringFunc(text, func(match string) string {
+			parts := pattern.FindStringSubmatch(match)
+
+			target := parts[2]
+			if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+				return match
+			}
+
+			label := parts[1]
+			if label == "" {
+				label = defaultText
+			}
+
+			links = append(links, `<a href="`+attrEscaper.Replace(target)+`">`+renderEmphasis(Escape(label))+"</a>")
+
+			return "\x00" + strconv.Itoa(len(links)-1) + "\x00"
+		})
+	}
+
+	replace(image, "image")
+	replace(link, "")
+
+	text = renderEmphasis(Escape(text))
+
+	return placeholder.ReplaceAllStringFunc(text, func(match string) string {
+		index, _ := strconv.Atoi(placeholder.FindStringSubmatch(match)[1])
+		return links[index]
+	})
+}
+
+func renderEmphasis(text string) string {
+	text = bold.ReplaceAllString(text, "<b>$1$2</b>")
+	text = strike.ReplaceAllString(text, "<s>$1</s>")
+
+	return italic.ReplaceAllString(text, "<i>$1</i>")
+}
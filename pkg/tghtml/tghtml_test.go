@@ -0,0 +1,113 @@
+package tghtml_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AFK068/bot/pkg/tghtml"
+)
+
+func Test_Escape(t *testing.T) {
+	assert.Equal(t, "a &lt; b &amp;&amp; c &gt; d", tghtml.Escape("a < b && c > d"))
+}
+
+func Test_FromHTML_StackExchangeBody(t *testing.T) {
+	body := `<p>How do I <strong>parse</strong> <a href="https://go.dev/doc" rel="nofollow">this</a>?</p>
+
+<pre class="lang-go"><code class="lang-go">if a &lt; b {
+    return
+}
+</code></pre>
+
+<ul>
+<li><p>first <em>item</em></p></li>
+<li>second<br>line</li>
+</ul>
+
+<p><img src="https://i.sstatic.net/x.png"> <a href="/questions/1">relative</a> <script>alert(1)</script></p>`
+
+	expected := "How do I <b>parse</b> <a href=\"https://go.dev/doc\">this</a>?\n\n" +
+		"<pre><code class=\"language-go\">if a &lt; b {\n    return\n}\n</code></pre>\n\n" +
+		"• first <i>item</i>\n• second\nline\n\nrelative"
+
+	assert.Equal(t, expected, tghtml.FromHTML(body))
+}
+
+func Test_FromHTML_UnclosedTags(t *testing.T) {
+	assert.Equal(t, "<b>bold <i>and italic</i></b>", tghtml.FromHTML("<b>bold <i>and italic"))
+	assert.Equal(t, "<b>bold</b> text", tghtml.FromHTML("<b>bold</i></b> text</p>"))
+}
+
+func Test_FromMarkdown_GitHubBody(t *testing.T) {
+	body := "## Summary\r\n\r\n" +
+		"<!-- Describe\r\nthe change -->\r\n" +
+		"Fixes **crash** in `a < b` when <input> is *empty*, see [docs](https://example.com/a_b*c*).\r\n\r\n" +
+		"```go\r\nif a < b {\r\n}\r\n```\r\n\r\n" +
+		"> quoted\r\n> text\r\n\r\n" +
+		"- one\r\n- [relative](docs/readme.md)"
+
+	expected := "<b>Summary</b>\n\n" +
+		"Fixes <b>crash</b> in <code>a &lt; b</code> when &lt;input&gt; is <i>empty</i>, " +
+		"see <a href=\"https://example.com/a_b*c*\">docs</a>.\n\n" +
+		"<pre><code class=\"language-go\">if a &lt; b {\n}</code></pre>\n\n" +
+		"<blockquote>quoted\ntext</blockquote>\n\n" +
+		"• one\n• [relative](docs/readme.md)"
+
+	assert.Equal(t, expected, tghtml.FromMarkdown(body))
+}
+
+func Test_FromMarkdown_UnclosedCodeBlock(t *testing.T) {
+	assert.Equal(t, "Logs:\n<pre>panic: &lt;nil&gt;</pre>", tghtml.FromMarkdown("Logs:\n```\npanic: <nil>"))
+}
+
+func Test_FromMarkdown_OverlappingEmphasis(t *testing.T) {
+	assert.Equal(t, "<b>a <i>b</i></b> c", tghtml.FromMarkdown("**a *b** c*"))
+}
+
+func Test_Truncate_Cyrillic(t *testing.T) {
+	text := "<b>Привет</b>, мир"
+
+	assert.Equal(t, text, tghtml.Truncate(text, 11))
+	assert.Equal(t, "<b>Приве…</b>", tghtml.Truncate(text, 6))
+	assert.Equal(t, "<b>Привет</b>,…", tghtml.Truncate(text, 9))
+}
+
+func Test_Truncate_ClosesTags(t *testing.T) {
+	text := `<pre><code class="language-go">a &lt; b</code></pre> done`
+
+	assert.Equal(t, `<pre><code class="language-go">a &lt;…</code></pre>`, tghtml.Truncate(text, 4))
+	assert.Equal(t, 4, tghtml.Length(tghtml.Truncate(text, 4)))
+}
+
+func Test_Split(t *testing.T) {
+	text := "<b>first line\nsecond line</b>\n" + strings.Repeat("я", 25)
+
+	parts := tghtml.Split(text, 12)
+
+	expected := []string{
+		"<b>first line\n</b>",
+		"<b>second line</b>\n",
+		strings.Repeat("я", 12),
+		strings.Repeat("я", 12),
+		"я",
+	}
+
+	require.Equal(t, expected, parts)
+
+	for _, part := range parts {
+		assert.LessOrEqual(t, tghtml.Length(part), 12)
+	}
+}
+
+func Test_Sanitize(t *testing.T) {
+	assert.Equal(t, "<b>bold</b> 1 &lt; 2 <a href=\"https://example.com\">x</a> y",
+		tghtml.Sanitize(`<b>bold</b> 1 < 2 <a href="https://example.com">x</a> <a href="javascript:alert(1)">y</a>`))
+	assert.Equal(t, "  keep\n\n  spaces", tghtml.Sanitize("  keep\n\n  spaces"))
+}
+
+func Test_PlainText(t *testing.T) {
+	assert.Equal(t, "a < b & c", tghtml.PlainText("<b>a &lt; b</b> &amp; <i>c</i>"))
+}
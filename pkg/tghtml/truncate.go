@@ -0,0 +1,180 @@
+package tghtml
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// element is a tag of Telegram HTML that is open at some point of the text.
+type element struct {
+	name string
+	raw  string
+}
+
+// Truncate cuts the Telegram HTML text to at most limit runes of visible text, ending it with
+// Ellipsis. The cut is made between runes and tags, the tags left open are closed.
+func Truncate(text string, limit int) string {
+	if Length(text) <= limit {
+		return text
+	}
+
+	var (
+		out  strings.Builder
+		open []element
+		size int
+	)
+
+	// The ellipsis takes the place of the last rune.
+	limit = max(limit-utf8.RuneCountInString(Ellipsis), 0)
+
+	z := html.NewTokenizer(strings.NewReader(text))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return out.String()
+		case html.TextToken:
+			runes := []rune(string(z.Text()))
+
+			if size+len(runes) > limit {
+				out.WriteString(Escape(strings.TrimRight(string(runes[:limit-size]), " \n")) + Ellipsis)
+				out.WriteString(closeTags(open))
+
+				return out.String()
+			}
+
+			out.Write(z.Raw())
+
+			size += len(runes)
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			open = append(open, element{name: string(name), raw: string(z.Raw())})
+
+			out.Write(z.Raw())
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			open = closeElement(open, string(name))
+
+			out.Write(z.Raw())
+		case html.SelfClosingTagToken, html.CommentToken, html.DoctypeToken:
+			out.Write(z.Raw())
+		}
+	}
+}
+
+// Split splits the Telegram HTML text into parts of at most limit runes of visible text,
+// at line breaks when possible. Tags open at the end of a part are closed and opened
+// again at the start of the next one, so every part can be sent on its own.
+func Split(text string, limit int) []string {
+	var (
+		parts   []string
+		current strings.Builder
+		open    []element
+		size    int
+	)
+
+	flush := func() {
+		if size == 0 {
+			return
+		}
+
+		current.WriteString(closeTags(open))
+		parts = append(parts, current.String())
+
+		current.Reset()
+
+		for _, tag := range open {
+			current.WriteString(tag.raw)
+		}
+
+		size = 0
+	}
+
+	z := html.NewTokenizer(strings.NewReader(text))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			flush()
+			return parts
+		case html.TextToken:
+			for _, line := range strings.SplitAfter(string(z.Text()), "\n") {
+				runes := []rune(line)
+
+				if size+len(runes) > limit {
+					flush()
+				}
+
+				for len(runes) > limit {
+					current.WriteString(Escape(string(runes[:limit])))
+					size = limit
+
+					flush()
+
+					runes = runes[limit:]
+				}
+
+				current.WriteString(Escape(string(runes)))
+				size += len(runes)
+			}
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			open = append(open, element{name: string(name), raw: string(z.Raw())})
+
+			current.Write(z.Raw())
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			open = closeElement(open, string(name))
+
+			current.Write(z.Raw())
+		case html.SelfClosingTagToken, html.CommentToken, html.DoctypeToken:
+			current.Write(z.Raw())
+		}
+	}
+}
+
+// Length returns the number of runes of the visible text of the Telegram HTML text.
+func Length(text string) int {
+	return utf8.RuneCountInString(PlainText(text))
+}
+
+// PlainText returns the visible text of the Telegram HTML text, for messages sent without formatting.
+func PlainText(text string) string {
+	var out strings.Builder
+
+	z := html.NewTokenizer(strings.NewReader(text))
+
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return out.String()
+		case html.TextToken:
+			out.Write(z.Text())
+		case html.StartTagToken, html.EndTagToken, html.SelfClosingTagToken, html.CommentToken, html.DoctypeToken:
+		}
+	}
+}
+
+// closeElement removes the innermost open element with the name and the elements opened inside it.
+func closeElement(open []element, name string) []element {
+	for i := len(open) - 1; i >= 0; i-- {
+		if open[i].name == name {
+			return open[:i]
+		}
+	}
+
+	return open
+}
+
+// closeTags returns the end tags of the open elements, the innermost first.
+func closeTags(open []element) string {
+	var tags strings.Builder
+
+	for i := len(open) - 1; i >= 0; i-- {
+		tags.WriteString("</" + open[i].name + ">")
+	}
+
+	return tags.String()
+}
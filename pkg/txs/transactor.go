@@ -24,8 +24,17 @@ func NewTxBeginner(db *pgxpool.Pool) *TxBeginner {
 	}
 }
 
+// WithTransaction runs the function in a transaction. Called inside another transaction it
+// runs in a savepoint, so its failure is rolled back without aborting the outer transaction.
 func (t *TxBeginner) WithTransaction(ctx context.Context, txFunc func(ctx context.Context) error) (err error) {
-	tx, err := t.db.Begin(ctx)
+	var tx pgx.Tx
+
+	if outer, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		tx, err = outer.Begin(ctx)
+	} else {
+		tx, err = t.db.Begin(ctx)
+	}
+
 	if err != nil {
 		return err
 	}